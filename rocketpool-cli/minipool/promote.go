@@ -23,17 +23,19 @@ func promoteMinipools(c *cli.Context) error {
 	}
 	defer rp.Close()
 
-	// Get minipool statuses
-	status, err := rp.MinipoolStatus()
+	// Get the vacant minipools
+	details, err := rp.GetMinipoolPromoteDetailsForNode()
 	if err != nil {
 		return err
 	}
 
 	// Get promotable minipools
-	promotableMinipools := []api.MinipoolDetails{}
-	for _, minipool := range status.Minipools {
+	promotableMinipools := []api.MinipoolPromoteDetails{}
+	for _, minipool := range details.Details {
 		if minipool.CanPromote {
 			promotableMinipools = append(promotableMinipools, minipool)
+		} else {
+			fmt.Printf("Minipool %s is still in its scrub period; it can be promoted at %s (%s from now).\n", minipool.Address.Hex(), minipool.PromotionTime.Format(TimeFormat), minipool.TimeUntilPromotion)
 		}
 	}
 
@@ -44,7 +46,7 @@ func promoteMinipools(c *cli.Context) error {
 	}
 
 	// Get selected minipools
-	var selectedMinipools []api.MinipoolDetails
+	var selectedMinipools []api.MinipoolPromoteDetails
 	if c.String("minipool") == "" {
 
 		// Prompt for minipool selection
@@ -59,7 +61,7 @@ func promoteMinipools(c *cli.Context) error {
 		if selected == 0 {
 			selectedMinipools = promotableMinipools
 		} else {
-			selectedMinipools = []api.MinipoolDetails{promotableMinipools[selected-1]}
+			selectedMinipools = []api.MinipoolPromoteDetails{promotableMinipools[selected-1]}
 		}
 
 	} else {
@@ -71,7 +73,7 @@ func promoteMinipools(c *cli.Context) error {
 			selectedAddress := common.HexToAddress(c.String("minipool"))
 			for _, minipool := range promotableMinipools {
 				if bytes.Equal(minipool.Address.Bytes(), selectedAddress.Bytes()) {
-					selectedMinipools = []api.MinipoolDetails{minipool}
+					selectedMinipools = []api.MinipoolPromoteDetails{minipool}
 					break
 				}
 			}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	rocketpoolapi "github.com/rocket-pool/rocketpool-go/rocketpool"
+	"github.com/rocket-pool/rocketpool-go/types"
 	"github.com/urfave/cli"
 
 	"github.com/rocket-pool/smartnode/shared/services/gas"
@@ -40,6 +41,21 @@ func stakeMinipools(c *cli.Context) error {
 	// Check for stakeable minipools
 	if len(stakeableMinipools) == 0 {
 		fmt.Println("No minipools can be staked.")
+
+		// Remind the user when the minipools still in their scrub period can be staked
+		for _, minipool := range status.Minipools {
+			if minipool.Status.Status != types.Prelaunch || minipool.Status.IsVacant {
+				continue
+			}
+			stakeTime, err := rp.GetMinipoolStakeTime(minipool.Address)
+			if err != nil {
+				fmt.Printf("WARNING: Couldn't get the stake time for minipool %s: %s\n", minipool.Address.Hex(), err.Error())
+				continue
+			}
+			if !stakeTime.CanStakeNow {
+				fmt.Printf("Minipool %s is still in its scrub period; it can be staked at %s (%s from now).\n", minipool.Address.Hex(), stakeTime.StakeTime.Format(TimeFormat), stakeTime.TimeUntilStake)
+			}
+		}
 		return nil
 	}
 
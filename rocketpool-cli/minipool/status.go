@@ -91,7 +91,7 @@ func getStatus(c *cli.Context) error {
 		// Minipools
 		for _, minipool := range minipools {
 			if !minipool.Finalised || c.Bool("include-finalized") {
-				printMinipoolDetails(minipool, status.LatestDelegate)
+				printMinipoolDetails(minipool, status.LatestDelegate, status.NetworkNodeFee)
 			}
 		}
 
@@ -105,7 +105,7 @@ func getStatus(c *cli.Context) error {
 
 		// Minipools
 		for _, minipool := range finalisedMinipools {
-			printMinipoolDetails(minipool, status.LatestDelegate)
+			printMinipoolDetails(minipool, status.LatestDelegate, status.NetworkNodeFee)
 		}
 	} else {
 		fmt.Printf("%d finalized minipool(s) (hidden)\n", len(finalisedMinipools))
@@ -135,7 +135,7 @@ func getStatus(c *cli.Context) error {
 
 }
 
-func printMinipoolDetails(minipool api.MinipoolDetails, latestDelegate common.Address) {
+func printMinipoolDetails(minipool api.MinipoolDetails, latestDelegate common.Address, networkNodeFee float64) {
 
 	fmt.Printf("--------------------\n")
 	fmt.Printf("\n")
@@ -151,6 +151,10 @@ func printMinipoolDetails(minipool api.MinipoolDetails, latestDelegate common.Ad
 	}
 	fmt.Printf("Status updated:        %s\n", minipool.Status.StatusTime.Format(TimeFormat))
 	fmt.Printf("Node fee:              %f%%\n", minipool.Node.Fee*100)
+	if minipool.CreationNodeFee != minipool.Node.Fee {
+		fmt.Printf("Node fee at creation:  %f%%\n", minipool.CreationNodeFee*100)
+	}
+	fmt.Printf("Network node fee:      %f%% (this minipool's fee is %+f%% from it)\n", networkNodeFee*100, (minipool.Node.Fee-networkNodeFee)*100)
 	fmt.Printf("Node deposit:          %.6f ETH\n", math.RoundDown(eth.WeiToEth(minipool.Node.DepositBalance), 6))
 
 	// Queue position
@@ -170,6 +170,9 @@ func createVacantMinipool(c *cli.Context, pubkey types.ValidatorPubkey) error {
 		if canDeposit.DepositDisabled {
 			fmt.Println("Vacant minipool deposits are currently disabled.")
 		}
+		if canDeposit.MinipoolLimitReached {
+			fmt.Printf("The network has reached its limit of %d active minipools (it currently has %d), so no more minipools can be created until the limit is raised.\n", canDeposit.MinipoolLimit, canDeposit.ActiveMinipoolCount)
+		}
 		return nil
 	}
 
@@ -176,6 +176,9 @@ func nodeDeposit(c *cli.Context) error {
 		if canDeposit.DepositDisabled {
 			fmt.Println("Node deposits are currently disabled.")
 		}
+		if canDeposit.MinipoolLimitReached {
+			fmt.Printf("The network has reached its limit of %d active minipools (it currently has %d), so no more minipools can be created until the limit is raised.\n", canDeposit.MinipoolLimit, canDeposit.ActiveMinipoolCount)
+		}
 		return nil
 	}
 
@@ -49,7 +49,7 @@ func signMessage(c *cli.Context) error {
 
 	// Print the signature
 	formattedSignature := PersonalSignature{
-		Address:   status.AccountAddress,
+		Address:   response.SignerAddress,
 		Message:   message,
 		Signature: response.SignedData,
 		Version:   fmt.Sprint(signatureVersion),
@@ -37,8 +37,8 @@ func getStatus(c *cli.Context) error {
 		return fmt.Errorf("Error loading configuration: %w", err)
 	}
 
-	// Get wallet status
-	walletStatus, err := rp.WalletStatus()
+	// Get the wallet and node status together
+	walletStatus, status, nodeStatusErr, err := rp.WalletAndNodeStatus()
 	if err != nil {
 		return err
 	}
@@ -65,15 +65,12 @@ func getStatus(c *cli.Context) error {
 		return err
 	}
 
-	// rp.NodeStatus() will fail with an error, but we can short-circuit it here.
+	// The node status will have failed with an error, but we can give a clearer one here.
 	if !walletStatus.WalletInitialized {
 		return errors.New("The node wallet is not initialized.")
 	}
-
-	// Get node status
-	status, err := rp.NodeStatus()
-	if err != nil {
-		return err
+	if nodeStatusErr != nil {
+		return nodeStatusErr
 	}
 
 	// Account address & balances
@@ -17,6 +17,11 @@ var ethClientRecentBlockThreshold, _ = time.ParseDuration("5m")
 
 func printClientStatus(status *api.ClientStatus, name string) {
 
+	if status.SyncStatus == api.EcSyncStatus_Pruning {
+		fmt.Printf("Your %s is pruning its state (%s); it will be available again once pruning finishes.\n", name, status.Error)
+		return
+	}
+
 	if status.Error != "" {
 		fmt.Printf("Your %s is unavailable (%s).\n", name, status.Error)
 		return
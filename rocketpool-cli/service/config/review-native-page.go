@@ -56,6 +56,14 @@ func NewReviewNativePage(md *mainDisplay, oldConfig *config.RocketPoolConfig, ne
 		if builder.String() == "" {
 			builder.WriteString("<No changes>")
 		}
+
+		warnings := newConfig.GetWarnings()
+		if len(warnings) > 0 {
+			builder.WriteString("\n\n[yellow]NOTE: Your configuration can be saved, but you may want to review the following:\n\n")
+			for _, warning := range warnings {
+				builder.WriteString(fmt.Sprintf("%s\n\n", warning))
+			}
+		}
 	}
 	changeBox.SetText(builder.String())
 
@@ -78,6 +78,14 @@ func NewReviewPage(md *mainDisplay, oldConfig *config.RocketPoolConfig, newConfi
 				containersToRestart = append(containersToRestart, container)
 			}
 		}
+
+		warnings := newConfig.GetWarnings()
+		if len(warnings) > 0 {
+			builder.WriteString("\n\n[yellow]NOTE: Your configuration can be saved, but you may want to review the following:\n\n")
+			for _, warning := range warnings {
+				builder.WriteString(fmt.Sprintf("%s\n\n", warning))
+			}
+		}
 	}
 
 	changeBox.SetText(builder.String())
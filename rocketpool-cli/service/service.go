@@ -542,6 +542,14 @@ func startService(c *cli.Context, ignoreConfigSuggestion bool) error {
 		fmt.Println(colorReset)
 		return nil
 	}
+	warnings := cfg.GetWarnings()
+	if len(warnings) > 0 {
+		fmt.Printf("%sNOTE: Your configuration has the following warnings:\n\n", colorYellow)
+		for _, warning := range warnings {
+			fmt.Printf("%s\n\n", warning)
+		}
+		fmt.Println(colorReset)
+	}
 
 	if !c.Bool("ignore-slash-timer") {
 		// Do the client swap check
@@ -1,6 +1,7 @@
 package api
 
 import (
+	"fmt"
 	"net/http"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -75,6 +76,23 @@ func RegisterCommands(app *cli.App, name string, aliases []string) {
 	apiservice.RegisterSubcommands(&command, "service", []string{"s"})
 	debug.RegisterSubcommands(&command, "debug", []string{"d"})
 
+	// Append a batch command so clients can run several commands with one call
+	command.Subcommands = append(command.Subcommands, cli.Command{
+		Name:      "batch",
+		Usage:     "Run several API commands and return their responses in order",
+		UsageText: "rocketpool api batch command...",
+		Action: func(c *cli.Context) error {
+			// Validate args
+			if c.NArg() == 0 {
+				return fmt.Errorf("Incorrect argument count; usage: %s", c.Command.UsageText)
+			}
+
+			// Run
+			api.PrintResponse(runBatch(c, c.Args()))
+			return nil
+		},
+	})
+
 	// Append a general wait-for-transaction command to support async operations
 	command.Subcommands = append(command.Subcommands, cli.Command{
 		Name:      "wait",
@@ -1,6 +1,8 @@
 package auction
 
 import (
+	"math/big"
+
 	"github.com/urfave/cli"
 
 	"github.com/rocket-pool/smartnode/shared/utils/api"
@@ -38,16 +40,34 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 				Name:      "lots",
 				Aliases:   []string{"l"},
 				Usage:     "Get RPL lots for auction",
-				UsageText: "rocketpool api auction lots",
+				UsageText: "rocketpool api auction lots [--counts-only] [--max-price wei]",
+				Flags: []cli.Flag{
+					cli.BoolFlag{
+						Name:  "counts-only, c",
+						Usage: "Only return the number of lots that can be claimed, bid on, or recovered instead of every lot's details",
+					},
+					cli.StringFlag{
+						Name:  "max-price, p",
+						Usage: "Only return the lots that can be bid on at or below this RPL price (in wei), sorted by price",
+					},
+				},
 				Action: func(c *cli.Context) error {
 
 					// Validate args
 					if err := cliutils.ValidateArgCount(c, 0); err != nil {
 						return err
 					}
+					var maxPrice *big.Int
+					if c.String("max-price") != "" {
+						var err error
+						maxPrice, err = cliutils.ValidatePositiveOrZeroWeiAmount("max price", c.String("max-price"))
+						if err != nil {
+							return err
+						}
+					}
 
 					// Run
-					api.PrintResponse(getLots(c))
+					api.PrintResponse(getLots(c, c.Bool("counts-only"), maxPrice))
 					return nil
 
 				},
@@ -1,13 +1,17 @@
 package auction
 
 import (
+	"fmt"
+	"math/big"
+	"sort"
+
 	"github.com/urfave/cli"
 
 	"github.com/rocket-pool/smartnode/shared/services"
 	"github.com/rocket-pool/smartnode/shared/types/api"
 )
 
-func getLots(c *cli.Context) (*api.AuctionLotsResponse, error) {
+func getLots(c *cli.Context, countsOnly bool, maxPrice *big.Int) (*api.AuctionLotsResponse, error) {
 
 	// Get services
 	if err := services.RequireNodeWallet(c); err != nil {
@@ -34,14 +38,57 @@ func getLots(c *cli.Context) (*api.AuctionLotsResponse, error) {
 		return nil, err
 	}
 
+	// Only get the lot counts if requested
+	if countsOnly {
+		if maxPrice != nil {
+			return nil, fmt.Errorf("The lot counts can't be filtered by price.")
+		}
+		lotCounts, err := getLotCounts(rp, nodeAccount.Address)
+		if err != nil {
+			return nil, err
+		}
+		response.LotCounts = lotCounts
+		return &response, nil
+	}
+
 	// Get lot details
 	lots, err := getAllLotDetails(rp, nodeAccount.Address)
 	if err != nil {
 		return nil, err
 	}
+
+	// Only keep the biddable lots at or below the max price, cheapest first
+	if maxPrice != nil {
+		lots = filterLotsByMaxPrice(lots, maxPrice)
+	}
 	response.Lots = lots
+	for _, lot := range lots {
+		if lot.ClaimAvailable {
+			response.LotCounts.ClaimAvailable++
+		}
+		if lot.BiddingAvailable {
+			response.LotCounts.BiddingAvailable++
+		}
+		if lot.RPLRecoveryAvailable {
+			response.LotCounts.RPLRecoveryAvailable++
+		}
+	}
 
 	// Return response
 	return &response, nil
 
 }
+
+// Get the lots that can be bid on at or below the provided price (in wei), sorted by price ascending
+func filterLotsByMaxPrice(lots []api.LotDetails, maxPrice *big.Int) []api.LotDetails {
+	filteredLots := []api.LotDetails{}
+	for _, lot := range lots {
+		if lot.BiddingAvailable && lot.Details.CurrentPrice.Cmp(maxPrice) <= 0 {
+			filteredLots = append(filteredLots, lot)
+		}
+	}
+	sort.SliceStable(filteredLots, func(i, j int) bool {
+		return filteredLots[i].Details.CurrentPrice.Cmp(filteredLots[j].Details.CurrentPrice) < 0
+	})
+	return filteredLots
+}
@@ -71,19 +71,9 @@ func getStatus(c *cli.Context) (*api.AuctionStatusResponse, error) {
 		if err != nil {
 			return err
 		}
-		lotCountDetails, err := getAllLotCountDetails(rp, nodeAccount.Address)
+		lotCounts, err := getLotCounts(rp, nodeAccount.Address)
 		if err == nil {
-			for _, details := range lotCountDetails {
-				if details.AddressHasBid && details.Cleared {
-					response.LotCounts.ClaimAvailable++
-				}
-				if !details.Cleared && details.HasRemainingRpl {
-					response.LotCounts.BiddingAvailable++
-				}
-				if details.Cleared && details.HasRemainingRpl && !details.RplRecovered {
-					response.LotCounts.RPLRecoveryAvailable++
-				}
-			}
+			response.LotCounts = lotCounts
 		}
 		return err
 	})
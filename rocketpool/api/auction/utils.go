@@ -146,6 +146,34 @@ func getAllLotCountDetails(rp *rocketpool.RocketPool, bidderAddress common.Addre
 
 }
 
+// Get the number of lots that can be claimed, bid on, or recovered
+func getLotCounts(rp *rocketpool.RocketPool, bidderAddress common.Address) (api.AuctionLotCounts, error) {
+
+	// Get lot count details
+	lotCountDetails, err := getAllLotCountDetails(rp, bidderAddress)
+	if err != nil {
+		return api.AuctionLotCounts{}, err
+	}
+
+	// Count the available actions
+	counts := api.AuctionLotCounts{}
+	for _, details := range lotCountDetails {
+		if details.AddressHasBid && details.Cleared {
+			counts.ClaimAvailable++
+		}
+		if !details.Cleared && details.HasRemainingRpl {
+			counts.BiddingAvailable++
+		}
+		if details.Cleared && details.HasRemainingRpl && !details.RplRecovered {
+			counts.RPLRecoveryAvailable++
+		}
+	}
+
+	// Return
+	return counts, nil
+
+}
+
 // Get a lot's count details
 func getLotCountDetails(rp *rocketpool.RocketPool, bidderAddress common.Address, lotIndex uint64) (lotCountDetails, error) {
 
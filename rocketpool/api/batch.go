@@ -0,0 +1,91 @@
+package api
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/goccy/go-json"
+	"github.com/urfave/cli"
+
+	apitypes "github.com/rocket-pool/smartnode/shared/types/api"
+)
+
+// Run several API commands in one call, returning each of their responses in order.
+// Each command runs in its own daemon process with the same global flags as this one, so their output can't mix and
+// a failing command only produces an error response for itself.
+func runBatch(c *cli.Context, commands []string) (*apitypes.BatchResponse, error) {
+
+	// Get the daemon binary
+	executable, err := os.Executable()
+	if err != nil {
+		return nil, fmt.Errorf("error getting the daemon path: %w", err)
+	}
+
+	// Response
+	response := apitypes.BatchResponse{
+		Responses: make([]json.RawMessage, len(commands)),
+	}
+
+	// Run each command and capture its response
+	for i, command := range commands {
+		args, err := getBatchCommandArgs(os.Args, len(commands), command)
+		if err == nil {
+			var output []byte
+			output, err = exec.Command(executable, args...).Output()
+			output = bytes.TrimSpace(output)
+			if len(output) > 0 && json.Valid(output) {
+				response.Responses[i] = output
+				continue
+			}
+			if err == nil {
+				err = fmt.Errorf("the command did not return a response")
+			}
+		}
+		response.Responses[i], err = getBatchErrorResponse(command, err)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Return response
+	return &response, nil
+
+}
+
+// Get the daemon arguments for one of the commands in a batch.
+// The batch's own arguments are replaced with the command's, keeping the global and API flags that came before them.
+// Commands are split on whitespace, so their arguments can't contain spaces.
+func getBatchCommandArgs(osArgs []string, batchArgCount int, command string) ([]string, error) {
+	commandArgs := strings.Fields(command)
+	if len(commandArgs) == 0 {
+		return nil, fmt.Errorf("empty command")
+	}
+	if commandArgs[0] == "batch" {
+		return nil, fmt.Errorf("batches cannot be nested")
+	}
+
+	// The arguments are [daemon, flags..., api, flags..., batch, commands...]
+	batchIndex := len(osArgs) - batchArgCount - 1
+	if batchIndex < 1 || osArgs[batchIndex] != "batch" {
+		return nil, fmt.Errorf("could not find the batch command in the daemon arguments")
+	}
+	args := make([]string, 0, batchIndex-1+len(commandArgs))
+	args = append(args, osArgs[1:batchIndex]...)
+	args = append(args, commandArgs...)
+	return args, nil
+}
+
+// Create the error response for a command in a batch that couldn't be run
+func getBatchErrorResponse(command string, commandErr error) (json.RawMessage, error) {
+	response, err := json.Marshal(apitypes.APIResponse{
+		Status: "error",
+		Error:  fmt.Sprintf("Could not run command '%s': %s", command, commandErr.Error()),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error encoding the response for command '%s': %w", command, err)
+	}
+	return response, nil
+}
@@ -0,0 +1,51 @@
+package api
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/goccy/go-json"
+
+	apitypes "github.com/rocket-pool/smartnode/shared/types/api"
+)
+
+func TestBatchCommandsKeepTheGlobalFlags(t *testing.T) {
+	osArgs := []string{"/go/bin/rocketpool", "--settings", "/.rocketpool/user-settings.yml", "--maxFee", "50", "api", "batch", "wallet status", "node status"}
+
+	args, err := getBatchCommandArgs(osArgs, 2, "node status")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []string{"--settings", "/.rocketpool/user-settings.yml", "--maxFee", "50", "api", "node", "status"}
+	if !reflect.DeepEqual(args, expected) {
+		t.Fatalf("expected args %v but got %v", expected, args)
+	}
+}
+
+func TestInvalidBatchCommandsAreRejected(t *testing.T) {
+	osArgs := []string{"/go/bin/rocketpool", "api", "batch", "batch node status", " "}
+	for _, command := range []string{"batch node status", " "} {
+		if _, err := getBatchCommandArgs(osArgs, 2, command); err == nil {
+			t.Fatalf("expected command '%s' to be rejected", command)
+		}
+	}
+
+	// The batch command has to be where the arguments say it is
+	if _, err := getBatchCommandArgs([]string{"/go/bin/rocketpool", "api", "node", "status"}, 1, "node status"); err == nil {
+		t.Fatal("expected an error when the batch command can't be found")
+	}
+}
+
+func TestBatchErrorResponsesAreNormalResponses(t *testing.T) {
+	responseBytes, err := getBatchErrorResponse("node status", json.Unmarshal([]byte("{"), &struct{}{}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var response apitypes.APIResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		t.Fatal(err)
+	}
+	if response.Status != "error" || response.Error == "" {
+		t.Fatalf("expected an error response but got %+v", response)
+	}
+}
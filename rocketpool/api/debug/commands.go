@@ -5,6 +5,7 @@ import (
 
 	"github.com/urfave/cli"
 
+	"github.com/rocket-pool/smartnode/shared/utils/api"
 	cliutils "github.com/rocket-pool/smartnode/shared/utils/cli"
 )
 
@@ -36,6 +37,24 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 
 				},
 			},
+
+			{
+				Name:      "dump-state",
+				Usage:     "Write the network state as the daemon sees it to a JSON file (requires debug routes to be enabled)",
+				UsageText: "rocketpool api debug dump-state path",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 1); err != nil {
+						return err
+					}
+
+					// Run
+					api.PrintResponse(dumpState(c, c.Args().Get(0)))
+					return nil
+
+				},
+			},
 		},
 	})
 }
@@ -0,0 +1,72 @@
+package debug
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/services/state"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+)
+
+// Write the network state at the head slot to a JSON file
+func dumpState(c *cli.Context, path string) (*api.DumpStateResponse, error) {
+
+	// Get services
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	if !cfg.Smartnode.EnableDebugRoutes.Value.(bool) {
+		return nil, fmt.Errorf("Dumping the network state is a debug route; please enable debug routes in the Smartnode settings first.")
+	}
+	if err := services.RequireRocketStorage(c); err != nil {
+		return nil, err
+	}
+	if err := services.RequireBeaconClientSynced(c); err != nil {
+		return nil, err
+	}
+	rp, err := services.GetRocketPool(c)
+	if err != nil {
+		return nil, err
+	}
+	bc, err := services.GetBeaconClient(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get the state
+	m, err := state.NewNetworkStateManager(rp, cfg, rp.Client, bc, nil)
+	if err != nil {
+		return nil, err
+	}
+	networkState, err := m.GetHeadState()
+	if err != nil {
+		return nil, fmt.Errorf("error getting network state: %w", err)
+	}
+
+	// Write it to the file
+	file, err := os.Create(path)
+	if err != nil {
+		return nil, fmt.Errorf("error creating state file [%s]: %w", path, err)
+	}
+	defer file.Close()
+	writer := bufio.NewWriter(file)
+	if err := networkState.ToJSON(writer); err != nil {
+		return nil, fmt.Errorf("error writing state file [%s]: %w", path, err)
+	}
+	if err := writer.Flush(); err != nil {
+		return nil, fmt.Errorf("error writing state file [%s]: %w", path, err)
+	}
+
+	// Return response
+	return &api.DumpStateResponse{
+		Path:             path,
+		ElBlockNumber:    networkState.ElBlockNumber,
+		BeaconSlotNumber: networkState.BeaconSlotNumber,
+	}, nil
+
+}
@@ -0,0 +1,218 @@
+package minipool
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rocket-pool/rocketpool-go/minipool"
+	"github.com/rocket-pool/rocketpool-go/rocketpool"
+	rptypes "github.com/rocket-pool/rocketpool-go/types"
+	"github.com/rocket-pool/rocketpool-go/utils/eth"
+	"github.com/urfave/cli"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/services/beacon"
+	"github.com/rocket-pool/smartnode/shared/services/config"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+)
+
+// Settings
+const (
+	DefaultAprEpochWindow uint64  = 225 // About one day
+	validatorPrincipalEth float64 = 32
+	secondsPerYear        float64 = 365.25 * 24 * 60 * 60
+	gweiPerEth            float64 = 1e9
+)
+
+func getMinipoolApr(c *cli.Context, minipoolAddress common.Address, epochWindow uint64) (*api.MinipoolAprResponse, error) {
+
+	// Get services
+	if err := services.RequireRocketStorage(c); err != nil {
+		return nil, err
+	}
+	if err := services.RequireBeaconClientSynced(c); err != nil {
+		return nil, err
+	}
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	rp, err := services.GetRocketPool(c)
+	if err != nil {
+		return nil, err
+	}
+	bc, err := services.GetBeaconClient(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.MinipoolAprResponse{
+		Address: minipoolAddress,
+	}
+
+	if epochWindow == 0 {
+		return nil, fmt.Errorf("The epoch window must be greater than zero.")
+	}
+
+	// Create minipool
+	mp, err := minipool.NewMinipool(rp, minipoolAddress, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// Data
+	var wg errgroup.Group
+	var pubkey rptypes.ValidatorPubkey
+	var nodeDepositBalance float64
+	var userDepositBalance float64
+	var head beacon.BeaconHead
+	var eth2Config beacon.Eth2Config
+
+	// Get data
+	wg.Go(func() error {
+		var err error
+		pubkey, err = minipool.GetMinipoolPubkey(rp, minipoolAddress, nil)
+		return err
+	})
+	wg.Go(func() error {
+		var err error
+		response.NodeFee, err = mp.GetNodeFee(nil)
+		return err
+	})
+	wg.Go(func() error {
+		balance, err := mp.GetNodeDepositBalance(nil)
+		if err == nil {
+			nodeDepositBalance = eth.WeiToEth(balance)
+		}
+		return err
+	})
+	wg.Go(func() error {
+		balance, err := mp.GetUserDepositBalance(nil)
+		if err == nil {
+			userDepositBalance = eth.WeiToEth(balance)
+		}
+		return err
+	})
+	wg.Go(func() error {
+		var err error
+		head, err = bc.GetBeaconHead()
+		return err
+	})
+	wg.Go(func() error {
+		var err error
+		eth2Config, err = bc.GetEth2Config()
+		return err
+	})
+
+	// Wait for data
+	if err := wg.Wait(); err != nil {
+		return nil, err
+	}
+
+	// Get the validator's current status
+	validator, err := bc.GetValidatorStatus(pubkey, nil)
+	if err != nil {
+		return nil, err
+	}
+	if !validator.Exists || validator.Index == "" || validator.ActivationEpoch >= head.Epoch {
+		response.NotActive = true
+		return &response, nil
+	}
+
+	// Only measure from when the validator became active if that's within the window
+	startEpoch := validator.ActivationEpoch
+	if head.Epoch-startEpoch > epochWindow {
+		startEpoch = head.Epoch - epochWindow
+	}
+	response.StartEpoch = startEpoch
+	response.EndEpoch = head.Epoch
+
+	// Get the validator's balance at the start of the window
+	startValidator, err := bc.GetValidatorStatusByIndex(validator.Index, &beacon.ValidatorStatusOptions{Epoch: &startEpoch})
+	if err != nil {
+		return nil, err
+	}
+
+	// Withdrawals during the window are swept from the validator's balance into the minipool, so add the minipool's
+	// balance change back in; a distribution during the window moves that balance out, so it can't be counted then
+	withdrawnEth, err := getMinipoolWithdrawnEth(rp, cfg, bc, minipoolAddress, startEpoch*eth2Config.SlotsPerEpoch)
+	if err != nil {
+		return nil, err
+	}
+	if withdrawnEth < 0 {
+		response.DistributedInWindow = true
+		withdrawnEth = 0
+	}
+	response.WithdrawnEth = withdrawnEth
+
+	// Annualize the rewards over the window
+	rewardsEth := (float64(validator.Balance)-float64(startValidator.Balance))/gweiPerEth + withdrawnEth
+	epochsPerYear := secondsPerYear / float64(eth2Config.SecondsPerSlot*eth2Config.SlotsPerEpoch)
+	response.ConsensusApr = rewardsEth / validatorPrincipalEth * epochsPerYear / float64(head.Epoch-startEpoch)
+
+	// The node gets the rewards on its own bond plus its commission on the rewards of the borrowed ETH
+	if nodeDepositBalance > 0 {
+		nodeShare := nodeDepositBalance + userDepositBalance*response.NodeFee
+		response.TotalApr = response.ConsensusApr * nodeShare / nodeDepositBalance
+	}
+
+	// Return response
+	return &response, nil
+
+}
+
+// Get the change in the minipool's EL balance between the block at the provided slot and the head block.
+// Historical balances come from the Archive EC if one is set, since the primary EC may have pruned that state.
+func getMinipoolWithdrawnEth(rp *rocketpool.RocketPool, cfg *config.RocketPoolConfig, bc beacon.Client, minipoolAddress common.Address, startSlot uint64) (float64, error) {
+
+	// Get the first proposed blocks at or before the start slot and the head
+	slot := startSlot
+	var startBlock beacon.BeaconBlock
+	for {
+		var exists bool
+		var err error
+		startBlock, exists, err = bc.GetBeaconBlock(fmt.Sprint(slot))
+		if err != nil {
+			return 0, fmt.Errorf("error getting Beacon block %d: %w", slot, err)
+		}
+		if exists {
+			break
+		}
+		if slot == 0 || startSlot-slot >= maxMissedSlotLookback {
+			return 0, fmt.Errorf("no Beacon block was found in the %d slots at or before slot %d", startSlot-slot+1, startSlot)
+		}
+		slot--
+	}
+	endBlock, exists, err := bc.GetBeaconBlock("head")
+	if err != nil {
+		return 0, fmt.Errorf("error getting head Beacon block: %w", err)
+	}
+	if !exists {
+		return 0, fmt.Errorf("head Beacon block not found")
+	}
+
+	// Get the balances
+	var ec rocketpool.ExecutionClient = rp.Client
+	if archiveEcUrl := cfg.Smartnode.ArchiveECUrl.Value.(string); archiveEcUrl != "" {
+		archiveEc, err := services.DialArchiveClient(cfg.Smartnode, archiveEcUrl)
+		if err != nil {
+			return 0, err
+		}
+		defer archiveEc.Close()
+		ec = archiveEc
+	}
+	startBalance, err := ec.BalanceAt(context.Background(), minipoolAddress, big.NewInt(0).SetUint64(startBlock.ExecutionBlockNumber))
+	if err != nil {
+		return 0, fmt.Errorf("error getting the minipool balance at block %d (this may require an Archive EC): %w", startBlock.ExecutionBlockNumber, err)
+	}
+	endBalance, err := ec.BalanceAt(context.Background(), minipoolAddress, big.NewInt(0).SetUint64(endBlock.ExecutionBlockNumber))
+	if err != nil {
+		return 0, fmt.Errorf("error getting the minipool balance at block %d: %w", endBlock.ExecutionBlockNumber, err)
+	}
+	return eth.WeiToEth(endBalance) - eth.WeiToEth(startBalance), nil
+
+}
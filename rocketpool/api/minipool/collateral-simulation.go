@@ -0,0 +1,120 @@
+package minipool
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rocket-pool/rocketpool-go/minipool"
+	"github.com/rocket-pool/rocketpool-go/node"
+	"github.com/rocket-pool/rocketpool-go/settings/protocol"
+	"github.com/rocket-pool/rocketpool-go/utils/eth"
+	"github.com/urfave/cli"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+	rputils "github.com/rocket-pool/smartnode/shared/utils/rp"
+)
+
+func simulateMinipoolCollateral(c *cli.Context, minipoolAddress common.Address, rplPrices []float64) (*api.MinipoolCollateralSimulationResponse, error) {
+
+	// Get services
+	if err := services.RequireRocketStorage(c); err != nil {
+		return nil, err
+	}
+	rp, err := services.GetRocketPool(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Make sure each price is usable; prices below 1 wei would be truncated to 0 and can't be divided by
+	rplPricesWei := make([]*big.Int, len(rplPrices))
+	for i, rplPrice := range rplPrices {
+		if math.IsNaN(rplPrice) || math.IsInf(rplPrice, 0) {
+			return nil, fmt.Errorf("RPL price %f is not a valid number.", rplPrice)
+		}
+		rplPricesWei[i] = eth.EthToWei(rplPrice)
+		if rplPricesWei[i].Sign() <= 0 {
+			return nil, fmt.Errorf("RPL price %g must be at least 1 wei.", rplPrice)
+		}
+	}
+
+	// Response
+	response := api.MinipoolCollateralSimulationResponse{
+		Results: []api.MinipoolCollateralSimulationResult{},
+	}
+
+	// Get the minipool's node
+	mp, err := minipool.NewMinipool(rp, minipoolAddress, nil)
+	if err != nil {
+		return nil, err
+	}
+	response.NodeAddress, err = mp.GetNodeAddress(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// Data
+	var wg errgroup.Group
+	var minStakeFraction *big.Int
+	var ethMatched *big.Int
+	var pendingMatchAmount *big.Int
+
+	// Get data
+	wg.Go(func() error {
+		var err error
+		response.RplStake, err = node.GetNodeRPLStake(rp, response.NodeAddress, nil)
+		return err
+	})
+	wg.Go(func() error {
+		var err error
+		minStakeFraction, err = protocol.GetMinimumPerMinipoolStakeRaw(rp, nil)
+		return err
+	})
+	wg.Go(func() error {
+		var err error
+		ethMatched, _, pendingMatchAmount, err = rputils.CheckCollateral(rp, response.NodeAddress, nil)
+		if err != nil {
+			return fmt.Errorf("error getting borrowed ETH: %w", err)
+		}
+		return nil
+	})
+
+	// Wait for data
+	if err := wg.Wait(); err != nil {
+		return nil, err
+	}
+
+	// Simulate the collateral at each price, including the pending bond reductions like the node status does
+	response.BorrowedEth = big.NewInt(0).Add(ethMatched, pendingMatchAmount)
+	for _, rplPrice := range rplPricesWei {
+		response.Results = append(response.Results, getCollateralAtPrice(response.RplStake, response.BorrowedEth, minStakeFraction, rplPrice))
+	}
+
+	// Return response
+	return &response, nil
+
+}
+
+// Get a node's collateral ratio and minimum RPL stake at the given RPL price
+func getCollateralAtPrice(rplStake *big.Int, borrowedEth *big.Int, minStakeFraction *big.Int, rplPrice *big.Int) api.MinipoolCollateralSimulationResult {
+	result := api.MinipoolCollateralSimulationResult{
+		RplPrice:      rplPrice,
+		MinimumStake:  big.NewInt(0),
+		AboveMinStake: true,
+	}
+
+	// Nodes without borrowed ETH have no minimum stake and an undefined ratio
+	if borrowedEth.Sign() == 0 {
+		result.CollateralRatio = -1
+		return result
+	}
+
+	result.CollateralRatio = eth.WeiToEth(rplPrice) * eth.WeiToEth(rplStake) / eth.WeiToEth(borrowedEth)
+	result.MinimumStake.Mul(borrowedEth, minStakeFraction)
+	result.MinimumStake.Div(result.MinimumStake, rplPrice)
+	result.AboveMinStake = (rplStake.Cmp(result.MinimumStake) >= 0)
+	return result
+}
@@ -1,6 +1,8 @@
 package minipool
 
 import (
+	"strings"
+
 	"github.com/urfave/cli"
 
 	"github.com/rocket-pool/smartnode/shared/utils/api"
@@ -55,6 +57,147 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 
 				},
 			},
+			{
+				Name:      "get-stake-time",
+				Usage:     "Get the earliest time a prelaunch minipool can be staked, once its scrub period has passed",
+				UsageText: "rocketpool api minipool get-stake-time minipool-address",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 1); err != nil {
+						return err
+					}
+					minipoolAddress, err := cliutils.ValidateAddress("minipool address", c.Args().Get(0))
+					if err != nil {
+						return err
+					}
+
+					// Run
+					api.PrintResponse(getMinipoolStakeTime(c, minipoolAddress))
+					return nil
+
+				},
+			},
+			{
+				Name:      "simulate-collateral",
+				Usage:     "Get the collateral ratio of the minipool's node, and whether it stays above the minimum RPL stake, at each of the provided RPL prices",
+				UsageText: "rocketpool api minipool simulate-collateral minipool-address rpl-prices",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 2); err != nil {
+						return err
+					}
+					minipoolAddress, err := cliutils.ValidateAddress("minipool address", c.Args().Get(0))
+					if err != nil {
+						return err
+					}
+					rplPrices := []float64{}
+					for _, element := range strings.Split(c.Args().Get(1), ",") {
+						rplPrice, err := cliutils.ValidatePositiveEthAmount("RPL price", strings.TrimSpace(element))
+						if err != nil {
+							return err
+						}
+						rplPrices = append(rplPrices, rplPrice)
+					}
+
+					// Run
+					api.PrintResponse(simulateMinipoolCollateral(c, minipoolAddress, rplPrices))
+					return nil
+
+				},
+			},
+			{
+				Name:      "next-action-time",
+				Usage:     "Get the next time the minipool can be staked, promoted, or have its bond reduced",
+				UsageText: "rocketpool api minipool next-action-time minipool-address",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 1); err != nil {
+						return err
+					}
+					minipoolAddress, err := cliutils.ValidateAddress("minipool address", c.Args().Get(0))
+					if err != nil {
+						return err
+					}
+
+					// Run
+					api.PrintResponse(getMinipoolNextActionTime(c, minipoolAddress))
+					return nil
+
+				},
+			},
+			{
+				Name:      "exit-timing",
+				Usage:     "Get the estimated time at which an exiting minipool's validator balance becomes withdrawable",
+				UsageText: "rocketpool api minipool exit-timing minipool-address",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 1); err != nil {
+						return err
+					}
+					minipoolAddress, err := cliutils.ValidateAddress("minipool address", c.Args().Get(0))
+					if err != nil {
+						return err
+					}
+
+					// Run
+					api.PrintResponse(getMinipoolExitTiming(c, minipoolAddress))
+					return nil
+
+				},
+			},
+			{
+				Name:      "finalization-details",
+				Usage:     "Get the minipool's balance and node / user split at the slot it became withdrawable (requires an Archive EC)",
+				UsageText: "rocketpool api minipool finalization-details minipool-address",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 1); err != nil {
+						return err
+					}
+					minipoolAddress, err := cliutils.ValidateAddress("minipool address", c.Args().Get(0))
+					if err != nil {
+						return err
+					}
+
+					// Run
+					api.PrintResponse(getMinipoolFinalizationDetails(c, minipoolAddress))
+					return nil
+
+				},
+			},
+			{
+				Name:      "apr",
+				Usage:     "Estimate the minipool's consensus and total APR from its recent Beacon balance",
+				UsageText: "rocketpool api minipool apr minipool-address",
+				Flags: []cli.Flag{
+					cli.Uint64Flag{
+						Name:  "epochs, e",
+						Usage: "The number of recent epochs to measure the validator's balance change over",
+						Value: DefaultAprEpochWindow,
+					},
+				},
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 1); err != nil {
+						return err
+					}
+					minipoolAddress, err := cliutils.ValidateAddress("minipool address", c.Args().Get(0))
+					if err != nil {
+						return err
+					}
+
+					// Run
+					api.PrintResponse(getMinipoolApr(c, minipoolAddress, c.Uint64("epochs")))
+					return nil
+
+				},
+			},
 			{
 				Name:      "stake",
 				Aliases:   []string{"t"},
@@ -78,6 +221,23 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 				},
 			},
 
+			{
+				Name:      "get-minipool-promote-details-for-node",
+				Usage:     "Check all of the node's vacant minipools for promotion eligibility, and return their details",
+				UsageText: "rocketpool api minipool get-minipool-promote-details-for-node",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+
+					// Run
+					api.PrintResponse(getMinipoolPromoteDetailsForNode(c))
+					return nil
+
+				},
+			},
 			{
 				Name:      "can-promote",
 				Usage:     "Check whether a vacant minipool is ready to be promoted",
@@ -293,6 +453,24 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 				},
 			},
 
+			{
+				Name:      "get-delegate-versions",
+				Usage:     "Get the delegate address and version of each of the node's minipools",
+				UsageText: "rocketpool api minipool get-delegate-versions",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+
+					// Run
+					api.PrintResponse(getDelegateVersions(c))
+					return nil
+
+				},
+			},
+
 			{
 				Name:      "can-delegate-upgrade",
 				Usage:     "Check whether the minipool delegate can be upgraded",
@@ -336,6 +514,49 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 				},
 			},
 
+			{
+				Name:      "can-upgrade-delegate",
+				Usage:     "Check whether a set of minipools can have their delegates upgraded, and why not for those that can't",
+				UsageText: "rocketpool api minipool can-upgrade-delegate minipool-addresses",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 1); err != nil {
+						return err
+					}
+					minipoolAddresses, err := cliutils.ValidateAddresses("minipool address", c.Args().Get(0))
+					if err != nil {
+						return err
+					}
+
+					// Run
+					api.PrintResponse(canUpgradeDelegates(c, minipoolAddresses))
+					return nil
+
+				},
+			},
+			{
+				Name:      "upgrade-delegate",
+				Usage:     "Upgrade the delegates of a set of minipools to the latest network delegate contract, skipping those that can't be upgraded",
+				UsageText: "rocketpool api minipool upgrade-delegate minipool-addresses",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 1); err != nil {
+						return err
+					}
+					minipoolAddresses, err := cliutils.ValidateAddresses("minipool address", c.Args().Get(0))
+					if err != nil {
+						return err
+					}
+
+					// Run
+					api.PrintResponse(upgradeDelegates(c, minipoolAddresses))
+					return nil
+
+				},
+			},
+
 			{
 				Name:      "can-delegate-rollback",
 				Usage:     "Check whether the minipool delegate can be rolled back",
@@ -674,6 +895,48 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 
 				},
 			},
+			{
+				Name:      "can-distribute-balances",
+				Usage:     "Check whether a set of minipools can have their ETH balances distributed, and why not for those that can't",
+				UsageText: "rocketpool api minipool can-distribute-balances minipool-addresses",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 1); err != nil {
+						return err
+					}
+					minipoolAddresses, err := cliutils.ValidateAddresses("minipool address", c.Args().Get(0))
+					if err != nil {
+						return err
+					}
+
+					// Run
+					api.PrintResponse(canDistributeBalances(c, minipoolAddresses))
+					return nil
+
+				},
+			},
+			{
+				Name:      "distribute-balances",
+				Usage:     "Distribute the ETH balances of a set of minipools, skipping those that can't be distributed",
+				UsageText: "rocketpool api minipool distribute-balances minipool-addresses",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 1); err != nil {
+						return err
+					}
+					minipoolAddresses, err := cliutils.ValidateAddresses("minipool address", c.Args().Get(0))
+					if err != nil {
+						return err
+					}
+
+					// Run
+					api.PrintResponse(distributeBalances(c, minipoolAddresses))
+					return nil
+
+				},
+			},
 
 			{
 				Name:      "import-key",
@@ -701,6 +964,46 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 				},
 			},
 
+			{
+				Name:      "get-withdrawal-credential-mismatches",
+				Usage:     "Check whether the validators of the node's minipools have the withdrawal credentials their minipools expect",
+				UsageText: "rocketpool api minipool get-withdrawal-credential-mismatches",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+
+					// Run
+					api.PrintResponse(getWithdrawalCredentialMismatches(c))
+					return nil
+
+				},
+			},
+
+			{
+				Name:      "get-minipools-by-status",
+				Usage:     "Get the node's minipools that are in any of the provided statuses",
+				UsageText: "rocketpool api minipool get-minipools-by-status statuses",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 1); err != nil {
+						return err
+					}
+					statuses, err := cliutils.ValidateMinipoolStatuses("minipool statuses", c.Args().Get(0))
+					if err != nil {
+						return err
+					}
+
+					// Run
+					api.PrintResponse(getNodeMinipoolsByStatus(c, statuses))
+					return nil
+
+				},
+			},
+
 			{
 				Name:      "can-change-withdrawal-creds",
 				Usage:     "Check whether a solo validator's withdrawal credentials can be changed to a minipool address",
@@ -0,0 +1,106 @@
+package minipool
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rocket-pool/rocketpool-go/rocketpool"
+	rpstate "github.com/rocket-pool/rocketpool-go/utils/state"
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/services/config"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+)
+
+func getDelegateVersions(c *cli.Context) (*api.MinipoolDelegateVersionsResponse, error) {
+
+	// Get services
+	if err := services.RequireNodeRegistered(c); err != nil {
+		return nil, err
+	}
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	rp, err := services.GetRocketPool(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.MinipoolDelegateVersionsResponse{
+		Minipools: []api.MinipoolDelegateDetails{},
+	}
+
+	// Get node account
+	nodeAccount, err := w.GetNodeAccount()
+	if err != nil {
+		return nil, err
+	}
+
+	// Get the latest delegate
+	response.LatestDelegateAddress, response.LatestDelegateVersion, err = getLatestDelegate(rp)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get the delegate of each of the node's minipools
+	minipools, err := getNodeMinipoolDelegateDetails(rp, cfg, nodeAccount.Address, response.LatestDelegateAddress)
+	if err != nil {
+		return nil, err
+	}
+	response.Minipools = minipools
+	for _, mpd := range minipools {
+		if !mpd.IsLatest && !mpd.Finalised {
+			response.OutdatedCount++
+		}
+	}
+
+	// Return response
+	return &response, nil
+
+}
+
+// Get the address and version of the latest minipool delegate
+func getLatestDelegate(rp *rocketpool.RocketPool) (common.Address, uint8, error) {
+	latestDelegateAddress, err := rp.GetAddress("rocketMinipoolDelegate", nil)
+	if err != nil {
+		return common.Address{}, 0, fmt.Errorf("error getting latest minipool delegate address: %w", err)
+	}
+	latestDelegateVersion, err := rocketpool.GetContractVersion(rp, *latestDelegateAddress, nil)
+	if err != nil {
+		return common.Address{}, 0, fmt.Errorf("error getting latest minipool delegate version: %w", err)
+	}
+	return *latestDelegateAddress, latestDelegateVersion, nil
+}
+
+// Get the delegate details of each of the node's minipools
+func getNodeMinipoolDelegateDetails(rp *rocketpool.RocketPool, cfg *config.RocketPoolConfig, nodeAddress common.Address, latestDelegateAddress common.Address) ([]api.MinipoolDelegateDetails, error) {
+	contracts, err := rpstate.NewNetworkContracts(rp, common.HexToAddress(cfg.Smartnode.GetMulticallAddress()), common.HexToAddress(cfg.Smartnode.GetBalanceBatcherAddress()), nil)
+	if err != nil {
+		return nil, fmt.Errorf("error getting network contracts: %w", err)
+	}
+	nativeDetails, err := rpstate.GetNodeNativeMinipoolDetails(rp, contracts, nodeAddress)
+	if err != nil {
+		return nil, fmt.Errorf("error getting minipool details: %w", err)
+	}
+
+	details := make([]api.MinipoolDelegateDetails, len(nativeDetails))
+	for i, mpd := range nativeDetails {
+		details[i] = api.MinipoolDelegateDetails{
+			Address:           mpd.MinipoolAddress,
+			Delegate:          mpd.Delegate,
+			EffectiveDelegate: mpd.EffectiveDelegate,
+			UseLatestDelegate: mpd.UseLatestDelegate,
+			Version:           mpd.Version,
+			Finalised:         mpd.Finalised,
+			IsLatest:          mpd.EffectiveDelegate == latestDelegateAddress,
+		}
+	}
+	return details, nil
+}
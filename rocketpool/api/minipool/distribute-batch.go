@@ -0,0 +1,185 @@
+package minipool
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rocket-pool/rocketpool-go/minipool"
+	"github.com/rocket-pool/rocketpool-go/rocketpool"
+	"github.com/urfave/cli"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/services/wallet"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+	"github.com/rocket-pool/smartnode/shared/utils/eth1"
+)
+
+func canDistributeBalances(c *cli.Context, minipoolAddresses []common.Address) (*api.CanDistributeBalancesResponse, error) {
+
+	// Get services
+	if err := services.RequireNodeRegistered(c); err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	rp, err := services.GetRocketPool(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.CanDistributeBalancesResponse{}
+
+	// Check each minipool
+	details, err := getBatchDistributeDetails(rp, w, minipoolAddresses)
+	if err != nil {
+		return nil, err
+	}
+	response.Details = details
+
+	// Sum the gas of the minipools that can be distributed
+	for _, minipoolDetails := range details {
+		if !minipoolDetails.CanDistribute {
+			continue
+		}
+		response.CanDistribute = true
+		response.GasInfo.EstGasLimit += minipoolDetails.GasInfo.EstGasLimit
+		response.GasInfo.SafeGasLimit += minipoolDetails.GasInfo.SafeGasLimit
+	}
+
+	// Return response
+	return &response, nil
+
+}
+
+func distributeBalances(c *cli.Context, minipoolAddresses []common.Address) (*api.DistributeBalancesResponse, error) {
+
+	// Get services
+	if err := services.RequireNodeRegistered(c); err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	rp, err := services.GetRocketPool(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.DistributeBalancesResponse{}
+
+	// Check each minipool again, since their state may have changed since the check
+	details, err := getBatchDistributeDetails(rp, w, minipoolAddresses)
+	if err != nil {
+		return nil, err
+	}
+	response.Details = details
+
+	// Get transactor
+	opts, err := w.GetNodeAccountTransactor()
+	if err != nil {
+		return nil, err
+	}
+
+	// Override the provided pending TX if requested; the following transactions use the nonces after it
+	err = eth1.CheckForNonceOverride(c, opts)
+	if err != nil {
+		return nil, fmt.Errorf("Error checking for nonce override: %w", err)
+	}
+
+	// Distribute each minipool's balance; a failure on one minipool is recorded against it so the hashes of the
+	// transactions that were already sent are still returned
+	for i := range response.Details {
+		minipoolDetails := &response.Details[i]
+		if !minipoolDetails.CanDistribute {
+			continue
+		}
+		hash, err := distributeMinipoolBalance(rp, minipoolDetails, opts)
+		if err != nil {
+			minipoolDetails.Error = err.Error()
+			continue
+		}
+		minipoolDetails.TxHash = hash
+		response.TxHashes = append(response.TxHashes, hash)
+		if opts.Nonce != nil {
+			opts.Nonce = big.NewInt(0).Add(opts.Nonce, big.NewInt(1))
+		}
+	}
+
+	// Return response
+	return &response, nil
+
+}
+
+// Send the distribute transaction for a single minipool
+func distributeMinipoolBalance(rp *rocketpool.RocketPool, minipoolDetails *api.MinipoolBatchDistributeDetails, opts *bind.TransactOpts) (common.Hash, error) {
+	mp, err := minipool.NewMinipool(rp, minipoolDetails.Address, nil)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	mpv3, success := minipool.GetMinipoolAsV3(mp)
+	if !success {
+		return common.Hash{}, fmt.Errorf("minipool %s cannot be converted to v3 (current version: %d)", minipoolDetails.Address.Hex(), mp.GetVersion())
+	}
+	opts.GasLimit = minipoolDetails.GasInfo.SafeGasLimit
+	hash, err := mpv3.DistributeBalance(true, opts)
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("error distributing the balance of minipool %s: %w", minipoolDetails.Address.Hex(), err)
+	}
+	return hash, nil
+}
+
+// Check whether each of the provided minipools can have its balance distributed, and why not if it can't
+func getBatchDistributeDetails(rp *rocketpool.RocketPool, w *wallet.Wallet, minipoolAddresses []common.Address) ([]api.MinipoolBatchDistributeDetails, error) {
+
+	// Get node account
+	nodeAccount, err := w.GetNodeAccount()
+	if err != nil {
+		return nil, err
+	}
+
+	// Load details in batches
+	details := make([]api.MinipoolBatchDistributeDetails, len(minipoolAddresses))
+	for bsi := 0; bsi < len(minipoolAddresses); bsi += MinipoolDetailsBatchSize {
+
+		// Get batch start & end index
+		msi := bsi
+		mei := bsi + MinipoolDetailsBatchSize
+		if mei > len(minipoolAddresses) {
+			mei = len(minipoolAddresses)
+		}
+
+		// Load details
+		var wg errgroup.Group
+		for mi := msi; mi < mei; mi++ {
+			mi := mi
+			wg.Go(func() error {
+				address := minipoolAddresses[mi]
+				minipoolDetails := &details[mi]
+				mp, err := minipool.NewMinipool(rp, address, nil)
+				if err != nil {
+					return fmt.Errorf("error creating binding for minipool %s: %w", address.Hex(), err)
+				}
+				if err := validateMinipoolOwner(mp, nodeAccount.Address); err != nil {
+					return err
+				}
+				minipoolDetails.MinipoolBalanceDistributionDetails, minipoolDetails.SkipReason, err = getMinipoolDistributeDetails(rp, w, address)
+				return err
+			})
+		}
+		if err := wg.Wait(); err != nil {
+			return nil, err
+		}
+
+	}
+
+	return details, nil
+
+}
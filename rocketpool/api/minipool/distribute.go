@@ -7,12 +7,14 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/rocket-pool/rocketpool-go/minipool"
+	"github.com/rocket-pool/rocketpool-go/rocketpool"
 	"github.com/rocket-pool/rocketpool-go/types"
 	"github.com/rocket-pool/rocketpool-go/utils/eth"
 	"github.com/urfave/cli"
 	"golang.org/x/sync/errgroup"
 
 	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/services/wallet"
 	"github.com/rocket-pool/smartnode/shared/types/api"
 	"github.com/rocket-pool/smartnode/shared/utils/eth1"
 )
@@ -46,7 +48,6 @@ func getDistributeBalanceDetails(c *cli.Context) (*api.GetDistributeBalanceDetai
 	}
 
 	// Load details in batches
-	zero := big.NewInt(0)
 	details := make([]api.MinipoolBalanceDistributionDetails, len(addresses))
 	for bsi := 0; bsi < len(addresses); bsi += MinipoolDetailsBatchSize {
 
@@ -62,121 +63,9 @@ func getDistributeBalanceDetails(c *cli.Context) (*api.GetDistributeBalanceDetai
 		for mi := msi; mi < mei; mi++ {
 			mi := mi
 			wg.Go(func() error {
-				address := addresses[mi]
-				minipoolDetails := &details[mi]
-				minipoolDetails.Address = address
-				minipoolDetails.Balance = big.NewInt(0)
-				minipoolDetails.Refund = big.NewInt(0)
-				minipoolDetails.NodeShareOfBalance = big.NewInt(0)
-				mp, err := minipool.NewMinipool(rp, address, nil)
-				if err != nil {
-					return fmt.Errorf("error creating binding for minipool %s: %w", address.Hex(), err)
-				}
-				minipoolDetails.MinipoolVersion = mp.GetVersion()
-
-				// Ignore minipools that are too old
-				if minipoolDetails.MinipoolVersion < 3 {
-					minipoolDetails.CanDistribute = false
-					return nil
-				}
-
-				var wg2 errgroup.Group
-				wg2.Go(func() error {
-					var err error
-					minipoolDetails.Balance, err = rp.Client.BalanceAt(context.Background(), address, nil)
-					if err != nil {
-						return fmt.Errorf("error getting balance of minipool %s: %w", address.Hex(), err)
-					}
-					return nil
-				})
-				wg2.Go(func() error {
-					var err error
-					minipoolDetails.Refund, err = mp.GetNodeRefundBalance(nil)
-					if err != nil {
-						return fmt.Errorf("error getting refund balance of minipool %s: %w", address.Hex(), err)
-					}
-					return nil
-				})
-				wg2.Go(func() error {
-					var err error
-					minipoolDetails.Status, err = mp.GetStatus(nil)
-					if err != nil {
-						return fmt.Errorf("error getting status of minipool %s: %w", address.Hex(), err)
-					}
-					return nil
-				})
-				wg2.Go(func() error {
-					var err error
-					minipoolDetails.IsFinalized, err = mp.GetFinalised(nil)
-					if err != nil {
-						return fmt.Errorf("error getting finalized status of minipool %s: %w", address.Hex(), err)
-					}
-					return nil
-				})
-
-				// Wait for data
-				if err := wg2.Wait(); err != nil {
-					return err
-				}
-
-				// Can't distribute a minipool that's already finalized
-				if minipoolDetails.IsFinalized {
-					minipoolDetails.CanDistribute = false
-					return nil
-				}
-
-				// Ignore minipools with 0 balance
-				if minipoolDetails.Balance.Cmp(zero) == 0 {
-					minipoolDetails.CanDistribute = false
-					return nil
-				}
-
-				// Handle staking minipools
-				if minipoolDetails.Status == types.Staking {
-					// Ignore minipools with a balance lower than the refund
-					if minipoolDetails.Balance.Cmp(minipoolDetails.Refund) == -1 {
-						minipoolDetails.CanDistribute = false
-						return nil
-					}
-
-					// Ignore minipools with an effective balance higher than v3 rewards-vs-exit cap
-					distributableBalance := big.NewInt(0).Sub(minipoolDetails.Balance, minipoolDetails.Refund)
-					eight := eth.EthToWei(8)
-					if distributableBalance.Cmp(eight) >= 0 {
-						minipoolDetails.CanDistribute = false
-						return nil
-					}
-
-					// Get the node share of the balance
-					minipoolDetails.NodeShareOfBalance, err = mp.CalculateNodeShare(distributableBalance, nil)
-					if err != nil {
-						return fmt.Errorf("error calculating node share for minipool %s: %w", address.Hex(), err)
-					}
-				} else if minipoolDetails.Status == types.Dissolved {
-					// Dissolved but non-finalized / non-closed minipools can just have the whole balance sent back to the NO
-					minipoolDetails.NodeShareOfBalance = minipoolDetails.Balance
-				} else {
-					// Can't distribute in any other state
-					minipoolDetails.CanDistribute = false
-					return nil
-				}
-
-				// Get gas estimate
-				opts, err := w.GetNodeAccountTransactor()
-				if err != nil {
-					return err
-				}
-				mpv3, success := minipool.GetMinipoolAsV3(mp)
-				if !success {
-					return fmt.Errorf("minipool %s cannot be converted to v3 (current version: %d)", address.Hex(), minipoolDetails.MinipoolVersion)
-				}
-				minipoolDetails.GasInfo, err = mpv3.EstimateDistributeBalanceGas(true, opts)
-				if err != nil {
-					return fmt.Errorf("error estimating gas to distribute minipool %s: %w", address.Hex(), err)
-				}
-
-				minipoolDetails.CanDistribute = true
-				return nil
+				var err error
+				details[mi], _, err = getMinipoolDistributeDetails(rp, w, addresses[mi])
+				return err
 			})
 		}
 		if err := wg.Wait(); err != nil {
@@ -242,3 +131,116 @@ func distributeBalance(c *cli.Context, minipoolAddress common.Address) (*api.Clo
 	return &response, nil
 
 }
+
+// Get a minipool's balance distribution details, and the reason it can't be distributed if it can't be
+func getMinipoolDistributeDetails(rp *rocketpool.RocketPool, w *wallet.Wallet, address common.Address) (api.MinipoolBalanceDistributionDetails, string, error) {
+	minipoolDetails := api.MinipoolBalanceDistributionDetails{
+		Address:            address,
+		Balance:            big.NewInt(0),
+		Refund:             big.NewInt(0),
+		NodeShareOfBalance: big.NewInt(0),
+	}
+	mp, err := minipool.NewMinipool(rp, address, nil)
+	if err != nil {
+		return minipoolDetails, "", fmt.Errorf("error creating binding for minipool %s: %w", address.Hex(), err)
+	}
+	minipoolDetails.MinipoolVersion = mp.GetVersion()
+
+	// Ignore minipools that are too old
+	if minipoolDetails.MinipoolVersion < 3 {
+		return minipoolDetails, fmt.Sprintf("minipool version %d is too old to distribute", minipoolDetails.MinipoolVersion), nil
+	}
+
+	var wg errgroup.Group
+	wg.Go(func() error {
+		var err error
+		minipoolDetails.Balance, err = rp.Client.BalanceAt(context.Background(), address, nil)
+		if err != nil {
+			return fmt.Errorf("error getting balance of minipool %s: %w", address.Hex(), err)
+		}
+		return nil
+	})
+	wg.Go(func() error {
+		var err error
+		minipoolDetails.Refund, err = mp.GetNodeRefundBalance(nil)
+		if err != nil {
+			return fmt.Errorf("error getting refund balance of minipool %s: %w", address.Hex(), err)
+		}
+		return nil
+	})
+	wg.Go(func() error {
+		var err error
+		minipoolDetails.Status, err = mp.GetStatus(nil)
+		if err != nil {
+			return fmt.Errorf("error getting status of minipool %s: %w", address.Hex(), err)
+		}
+		return nil
+	})
+	wg.Go(func() error {
+		var err error
+		minipoolDetails.IsFinalized, err = mp.GetFinalised(nil)
+		if err != nil {
+			return fmt.Errorf("error getting finalized status of minipool %s: %w", address.Hex(), err)
+		}
+		return nil
+	})
+
+	// Wait for data
+	if err := wg.Wait(); err != nil {
+		return minipoolDetails, "", err
+	}
+
+	// Can't distribute a minipool that's already finalized
+	if minipoolDetails.IsFinalized {
+		return minipoolDetails, "minipool is already finalized", nil
+	}
+
+	// Ignore minipools with 0 balance
+	if minipoolDetails.Balance.Sign() == 0 {
+		return minipoolDetails, "minipool has no balance to distribute", nil
+	}
+
+	// Handle staking minipools
+	if minipoolDetails.Status == types.Staking {
+		// Ignore minipools with a balance lower than the refund
+		if minipoolDetails.Balance.Cmp(minipoolDetails.Refund) == -1 {
+			return minipoolDetails, "minipool balance is lower than the node's refund", nil
+		}
+
+		// Ignore minipools with an effective balance higher than v3 rewards-vs-exit cap
+		distributableBalance := big.NewInt(0).Sub(minipoolDetails.Balance, minipoolDetails.Refund)
+		eight := eth.EthToWei(8)
+		if distributableBalance.Cmp(eight) >= 0 {
+			return minipoolDetails, "minipool balance is 8 ETH or more, so it must be distributed by exiting and closing it", nil
+		}
+
+		// Get the node share of the balance
+		minipoolDetails.NodeShareOfBalance, err = mp.CalculateNodeShare(distributableBalance, nil)
+		if err != nil {
+			return minipoolDetails, "", fmt.Errorf("error calculating node share for minipool %s: %w", address.Hex(), err)
+		}
+	} else if minipoolDetails.Status == types.Dissolved {
+		// Dissolved but non-finalized / non-closed minipools can just have the whole balance sent back to the NO
+		minipoolDetails.NodeShareOfBalance = minipoolDetails.Balance
+	} else {
+		// Can't distribute in any other state
+		return minipoolDetails, fmt.Sprintf("minipool status is %s", minipoolDetails.Status.String()), nil
+	}
+
+	// Get gas estimate
+	opts, err := w.GetNodeAccountTransactor()
+	if err != nil {
+		return minipoolDetails, "", err
+	}
+	mpv3, success := minipool.GetMinipoolAsV3(mp)
+	if !success {
+		return minipoolDetails, "", fmt.Errorf("minipool %s cannot be converted to v3 (current version: %d)", address.Hex(), minipoolDetails.MinipoolVersion)
+	}
+	minipoolDetails.GasInfo, err = mpv3.EstimateDistributeBalanceGas(true, opts)
+	if err != nil {
+		return minipoolDetails, "", fmt.Errorf("error estimating gas to distribute minipool %s: %w", address.Hex(), err)
+	}
+
+	minipoolDetails.CanDistribute = true
+	return minipoolDetails, "", nil
+}
@@ -0,0 +1,99 @@
+package minipool
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rocket-pool/rocketpool-go/minipool"
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/services/beacon"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+)
+
+// The Beacon Chain's placeholder epoch for exits and withdrawals that haven't been scheduled
+const farFutureEpoch uint64 = math.MaxUint64
+
+// The number of epochs between an unslashed validator's exit and when its balance can be withdrawn (MIN_VALIDATOR_WITHDRAWABILITY_DELAY)
+const minValidatorWithdrawabilityDelay uint64 = 256
+
+func getMinipoolExitTiming(c *cli.Context, minipoolAddress common.Address) (*api.MinipoolExitTimingResponse, error) {
+
+	// Get services
+	if err := services.RequireRocketStorage(c); err != nil {
+		return nil, err
+	}
+	if err := services.RequireBeaconClientSynced(c); err != nil {
+		return nil, err
+	}
+	rp, err := services.GetRocketPool(c)
+	if err != nil {
+		return nil, err
+	}
+	bc, err := services.GetBeaconClient(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.MinipoolExitTimingResponse{
+		Address: minipoolAddress,
+	}
+
+	// Get the validator
+	pubkey, err := minipool.GetMinipoolPubkey(rp, minipoolAddress, nil)
+	if err != nil {
+		return nil, err
+	}
+	validator, err := bc.GetValidatorStatus(pubkey, nil)
+	if err != nil {
+		return nil, err
+	}
+	if !validator.Exists {
+		return nil, fmt.Errorf("Minipool %s does not have a validator on the Beacon Chain.", minipoolAddress.Hex())
+	}
+	response.ValidatorState = validator.Status
+
+	// Validators that haven't had an exit scheduled aren't exiting
+	if validator.ExitEpoch == farFutureEpoch {
+		return &response, nil
+	}
+	response.IsExiting = true
+	response.ExitEpoch = validator.ExitEpoch
+
+	// The Beacon Chain sets the withdrawable epoch when it schedules the exit, including the longer delay for slashed validators;
+	// if it hasn't been provided, fall back to the standard delay after the exit epoch
+	response.WithdrawableEpoch = validator.WithdrawableEpoch
+	if response.WithdrawableEpoch == farFutureEpoch {
+		response.WithdrawableEpoch = validator.ExitEpoch + minValidatorWithdrawabilityDelay
+	}
+
+	// Get the estimated times of the exit and withdrawability; the balance is paid out by the next withdrawal sweep after that
+	eth2Config, err := bc.GetEth2Config()
+	if err != nil {
+		return nil, err
+	}
+	head, err := bc.GetBeaconHead()
+	if err != nil {
+		return nil, err
+	}
+	response.ExitTime = getEpochTime(eth2Config, response.ExitEpoch)
+	response.WithdrawableTime = getEpochTime(eth2Config, response.WithdrawableEpoch)
+	response.IsWithdrawable = response.WithdrawableEpoch <= head.Epoch
+	if !response.IsWithdrawable {
+		currentEpochTime := getEpochTime(eth2Config, head.Epoch)
+		response.TimeUntilWithdrawable = response.WithdrawableTime.Sub(currentEpochTime)
+	}
+
+	// Return response
+	return &response, nil
+
+}
+
+// Get the time at which an epoch starts
+func getEpochTime(eth2Config beacon.Eth2Config, epoch uint64) time.Time {
+	return time.Unix(int64(eth2Config.GenesisTime+epoch*eth2Config.SecondsPerEpoch), 0)
+}
@@ -0,0 +1,162 @@
+package minipool
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/rocket-pool/rocketpool-go/minipool"
+	"github.com/rocket-pool/rocketpool-go/rocketpool"
+	"github.com/rocket-pool/rocketpool-go/utils/eth"
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/services/beacon"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+)
+
+// The most slots to walk back through when looking for a proposed block before a missed slot
+const maxMissedSlotLookback uint64 = 64
+
+func getMinipoolFinalizationDetails(c *cli.Context, minipoolAddress common.Address) (*api.MinipoolFinalizationDetailsResponse, error) {
+
+	// Get services
+	if err := services.RequireRocketStorage(c); err != nil {
+		return nil, err
+	}
+	if err := services.RequireBeaconClientSynced(c); err != nil {
+		return nil, err
+	}
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	rp, err := services.GetRocketPool(c)
+	if err != nil {
+		return nil, err
+	}
+	bc, err := services.GetBeaconClient(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.MinipoolFinalizationDetailsResponse{
+		Address: minipoolAddress,
+	}
+
+	// Historical minipool state requires an archive EC
+	archiveEcUrl := cfg.Smartnode.ArchiveECUrl.Value.(string)
+	if archiveEcUrl == "" {
+		return nil, fmt.Errorf("Getting a minipool's finalization details requires an Archive EC, but one is not specified.")
+	}
+
+	// Get the validator
+	pubkey, err := minipool.GetMinipoolPubkey(rp, minipoolAddress, nil)
+	if err != nil {
+		return nil, err
+	}
+	validator, err := bc.GetValidatorStatus(pubkey, nil)
+	if err != nil {
+		return nil, err
+	}
+	if !validator.Exists {
+		return nil, fmt.Errorf("Minipool %s does not have a validator on the Beacon Chain.", minipoolAddress.Hex())
+	}
+
+	// Make sure it's withdrawable
+	head, err := bc.GetBeaconHead()
+	if err != nil {
+		return nil, err
+	}
+	if validator.WithdrawableEpoch > head.Epoch {
+		return nil, fmt.Errorf("Minipool %s is not withdrawable yet.", minipoolAddress.Hex())
+	}
+	eth2Config, err := bc.GetEth2Config()
+	if err != nil {
+		return nil, err
+	}
+
+	// Get the first proposed block at or before the withdrawable slot
+	withdrawableSlot := validator.WithdrawableEpoch * eth2Config.SlotsPerEpoch
+	slot := withdrawableSlot
+	var block beacon.BeaconBlock
+	for {
+		var exists bool
+		block, exists, err = bc.GetBeaconBlock(fmt.Sprint(slot))
+		if err != nil {
+			return nil, fmt.Errorf("error getting Beacon block %d: %w", slot, err)
+		}
+		if exists {
+			break
+		}
+		if slot == 0 || withdrawableSlot-slot >= maxMissedSlotLookback {
+			return nil, fmt.Errorf("No Beacon block was found in the %d slots at or before slot %d.", withdrawableSlot-slot+1, withdrawableSlot)
+		}
+		slot--
+	}
+	response.WithdrawableSlot = slot
+	response.ExecutionBlock = block.ExecutionBlockNumber
+
+	// Get the validator's balance at that slot
+	withdrawableValidator, err := bc.GetValidatorStatus(pubkey, &beacon.ValidatorStatusOptions{Slot: &slot})
+	if err != nil {
+		return nil, err
+	}
+	response.BeaconBalance = big.NewInt(0).Mul(big.NewInt(0).SetUint64(withdrawableValidator.Balance), big.NewInt(int64(eth.WeiPerGwei)))
+
+	// Connect to the Archive EC
+	ec, err := ethclient.Dial(archiveEcUrl)
+	if err != nil {
+		return nil, fmt.Errorf("Error connecting to archive EC: %w", err)
+	}
+	defer ec.Close()
+	archiveRp, err := rocketpool.NewRocketPool(ec, common.HexToAddress(cfg.Smartnode.GetStorageAddress()))
+	if err != nil {
+		return nil, fmt.Errorf("Error creating Rocket Pool client connected to archive EC: %w", err)
+	}
+	opts := &bind.CallOpts{
+		BlockNumber: big.NewInt(0).SetUint64(block.ExecutionBlockNumber),
+	}
+
+	// Get the minipool's balance and refund at that block
+	mp, err := minipool.NewMinipool(archiveRp, minipoolAddress, opts)
+	if err != nil {
+		return nil, err
+	}
+	mpv3, success := minipool.GetMinipoolAsV3(mp)
+	if !success {
+		return nil, fmt.Errorf("Minipool %s is version %d; finalization details are only available for version 3 and higher.", minipoolAddress.Hex(), mp.GetVersion())
+	}
+	response.MinipoolBalance, err = ec.BalanceAt(context.Background(), minipoolAddress, opts.BlockNumber)
+	if err != nil {
+		return nil, fmt.Errorf("error getting balance of minipool %s: %w", minipoolAddress.Hex(), err)
+	}
+	response.Refund, err = mpv3.GetNodeRefundBalance(opts)
+	if err != nil {
+		return nil, fmt.Errorf("error getting refund balance of minipool %s: %w", minipoolAddress.Hex(), err)
+	}
+
+	// Calculate the split of everything that will be distributed once the withdrawal is swept
+	response.TotalBalance = big.NewInt(0).Add(response.MinipoolBalance, response.BeaconBalance)
+	distributableBalance := big.NewInt(0).Sub(response.TotalBalance, response.Refund)
+	if distributableBalance.Sign() < 0 {
+		distributableBalance.SetUint64(0)
+	}
+	response.NodeShare, err = mpv3.CalculateNodeShare(distributableBalance, opts)
+	if err != nil {
+		return nil, fmt.Errorf("error calculating node share for minipool %s: %w", minipoolAddress.Hex(), err)
+	}
+	response.NodeShare.Add(response.NodeShare, response.Refund)
+	response.UserShare, err = mpv3.CalculateUserShare(distributableBalance, opts)
+	if err != nil {
+		return nil, fmt.Errorf("error calculating user share for minipool %s: %w", minipoolAddress.Hex(), err)
+	}
+
+	// Return response
+	return &response, nil
+
+}
@@ -0,0 +1,145 @@
+package minipool
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rocket-pool/rocketpool-go/minipool"
+	"github.com/rocket-pool/rocketpool-go/settings/protocol"
+	"github.com/rocket-pool/rocketpool-go/settings/trustednode"
+	rptypes "github.com/rocket-pool/rocketpool-go/types"
+	"github.com/urfave/cli"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+	rputils "github.com/rocket-pool/smartnode/shared/utils/rp"
+)
+
+func getMinipoolNextActionTime(c *cli.Context, minipoolAddress common.Address) (*api.MinipoolNextActionTimeResponse, error) {
+
+	// Get services
+	if err := services.RequireRocketStorage(c); err != nil {
+		return nil, err
+	}
+	rp, err := services.GetRocketPool(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.MinipoolNextActionTimeResponse{
+		Action: api.MinipoolNextAction_None,
+	}
+
+	// Create minipool
+	mp, err := minipool.NewMinipool(rp, minipoolAddress, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// Data
+	var wg errgroup.Group
+	var status minipool.StatusDetails
+	var latestBlockTime time.Time
+	var scrubPeriodSeconds uint64
+	var promotionScrubPeriodSeconds uint64
+	var launchTimeout time.Duration
+	var reductionWindowStart uint64
+	var reductionWindowLength uint64
+	var reduceBondTime time.Time
+	var reduceBondCancelled bool
+
+	// Get data
+	wg.Go(func() error {
+		var err error
+		status, err = mp.GetStatusDetails(nil)
+		return err
+	})
+	wg.Go(func() error {
+		latestEth1Block, err := rp.Client.HeaderByNumber(context.Background(), nil)
+		if err != nil {
+			return fmt.Errorf("Can't get the latest block time: %w", err)
+		}
+		latestBlockTime = time.Unix(int64(latestEth1Block.Time), 0)
+		return nil
+	})
+	wg.Go(func() error {
+		var err error
+		scrubPeriodSeconds, err = trustednode.GetScrubPeriod(rp, nil)
+		return err
+	})
+	wg.Go(func() error {
+		var err error
+		promotionScrubPeriodSeconds, err = trustednode.GetPromotionScrubPeriod(rp, nil)
+		return err
+	})
+	wg.Go(func() error {
+		var err error
+		launchTimeout, err = protocol.GetMinipoolLaunchTimeout(rp, nil)
+		return err
+	})
+	wg.Go(func() error {
+		var err error
+		reductionWindowStart, err = trustednode.GetBondReductionWindowStart(rp, nil)
+		return err
+	})
+	wg.Go(func() error {
+		var err error
+		reductionWindowLength, err = trustednode.GetBondReductionWindowLength(rp, nil)
+		return err
+	})
+	wg.Go(func() error {
+		var err error
+		reduceBondTime, err = minipool.GetReduceBondTime(rp, minipoolAddress, nil)
+		return err
+	})
+	wg.Go(func() error {
+		var err error
+		reduceBondCancelled, err = minipool.GetReduceBondCancelled(rp, minipoolAddress, nil)
+		return err
+	})
+
+	// Wait for data
+	if err := wg.Wait(); err != nil {
+		return nil, err
+	}
+
+	switch status.Status {
+	case rptypes.Prelaunch:
+		// Prelaunch minipools can be staked (or promoted if vacant) once the scrub period has passed, until they time out
+		scrubPeriod := time.Duration(scrubPeriodSeconds) * time.Second
+		response.Action = api.MinipoolNextAction_Stake
+		if status.IsVacant {
+			scrubPeriod = time.Duration(promotionScrubPeriodSeconds) * time.Second
+			response.Action = api.MinipoolNextAction_Promote
+		}
+		response.ActionTime = status.StatusTime.Add(scrubPeriod)
+		response.Deadline = status.StatusTime.Add(launchTimeout)
+
+	case rptypes.Staking:
+		// Staking minipools can reduce their bond during the window after a reduction was started
+		zeroTime := time.Unix(0, 0)
+		if reduceBondTime == zeroTime || reduceBondCancelled {
+			break
+		}
+		windowEnd := reduceBondTime.Add(time.Duration(reductionWindowStart+reductionWindowLength) * time.Second)
+		if !latestBlockTime.Before(windowEnd) {
+			break
+		}
+		response.Action = api.MinipoolNextAction_ReduceBond
+		response.ActionTime = reduceBondTime.Add(time.Duration(reductionWindowStart) * time.Second)
+		response.Deadline = windowEnd
+	}
+
+	// Get the time until the action is available
+	if response.Action != api.MinipoolNextAction_None {
+		response.TimeUntilAction = rputils.GetTimeUntil(response.ActionTime, latestBlockTime)
+	}
+
+	// Return response
+	return &response, nil
+
+}
@@ -7,12 +7,16 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/rocket-pool/rocketpool-go/minipool"
+	"github.com/rocket-pool/rocketpool-go/settings/protocol"
 	"github.com/rocket-pool/rocketpool-go/settings/trustednode"
+	rptypes "github.com/rocket-pool/rocketpool-go/types"
 	"github.com/urfave/cli"
+	"golang.org/x/sync/errgroup"
 
 	"github.com/rocket-pool/smartnode/shared/services"
 	"github.com/rocket-pool/smartnode/shared/types/api"
 	"github.com/rocket-pool/smartnode/shared/utils/eth1"
+	rputils "github.com/rocket-pool/smartnode/shared/utils/rp"
 )
 
 func canPromoteMinipool(c *cli.Context, minipoolAddress common.Address) (*api.CanPromoteMinipoolResponse, error) {
@@ -162,3 +166,117 @@ func promoteMinipool(c *cli.Context, minipoolAddress common.Address) (*api.Stake
 	return &response, nil
 
 }
+
+func getMinipoolPromoteDetailsForNode(c *cli.Context) (*api.GetMinipoolPromoteDetailsForNodeResponse, error) {
+
+	// Get services
+	if err := services.RequireNodeRegistered(c); err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	rp, err := services.GetRocketPool(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.GetMinipoolPromoteDetailsForNodeResponse{
+		Details: []api.MinipoolPromoteDetails{},
+	}
+
+	// Get node account
+	nodeAccount, err := w.GetNodeAccount()
+	if err != nil {
+		return nil, err
+	}
+
+	// Data
+	var wg1 errgroup.Group
+	var addresses []common.Address
+	var promotionScrubPeriodSeconds uint64
+	var launchTimeout time.Duration
+	var latestBlockTime time.Time
+
+	// Get data
+	wg1.Go(func() error {
+		var err error
+		addresses, err = minipool.GetNodeMinipoolAddresses(rp, nodeAccount.Address, nil)
+		return err
+	})
+	wg1.Go(func() error {
+		var err error
+		promotionScrubPeriodSeconds, err = trustednode.GetPromotionScrubPeriod(rp, nil)
+		return err
+	})
+	wg1.Go(func() error {
+		var err error
+		launchTimeout, err = protocol.GetMinipoolLaunchTimeout(rp, nil)
+		return err
+	})
+	wg1.Go(func() error {
+		latestEth1Block, err := rp.Client.HeaderByNumber(context.Background(), nil)
+		if err != nil {
+			return fmt.Errorf("Can't get the latest block time: %w", err)
+		}
+		latestBlockTime = time.Unix(int64(latestEth1Block.Time), 0)
+		return nil
+	})
+
+	// Wait for data
+	if err := wg1.Wait(); err != nil {
+		return nil, err
+	}
+	promotionScrubPeriod := time.Duration(promotionScrubPeriodSeconds) * time.Second
+
+	// Get the status of each minipool in batches
+	statuses := make([]minipool.StatusDetails, len(addresses))
+	for bsi := 0; bsi < len(addresses); bsi += MinipoolDetailsBatchSize {
+
+		// Get batch start & end index
+		msi := bsi
+		mei := bsi + MinipoolDetailsBatchSize
+		if mei > len(addresses) {
+			mei = len(addresses)
+		}
+
+		// Load statuses
+		var wg errgroup.Group
+		for mi := msi; mi < mei; mi++ {
+			mi := mi
+			wg.Go(func() error {
+				mp, err := minipool.NewMinipool(rp, addresses[mi], nil)
+				if err != nil {
+					return err
+				}
+				statuses[mi], err = mp.GetStatusDetails(nil)
+				return err
+			})
+		}
+		if err := wg.Wait(); err != nil {
+			return nil, err
+		}
+
+	}
+
+	// Vacant minipools stay in prelaunch until they're promoted, so the rest have either been promoted or dissolved
+	for mi, status := range statuses {
+		if !status.IsVacant || status.Status != rptypes.Prelaunch {
+			continue
+		}
+		promotionTime := status.StatusTime.Add(promotionScrubPeriod)
+		response.Details = append(response.Details, api.MinipoolPromoteDetails{
+			Address:            addresses[mi],
+			CanPromote:         promotionTime.Before(latestBlockTime),
+			PromotionTime:      promotionTime,
+			TimeUntilPromotion: rputils.GetTimeUntil(promotionTime, latestBlockTime),
+			TimeUntilDissolve:  rputils.GetTimeUntil(status.StatusTime.Add(launchTimeout), latestBlockTime),
+		})
+	}
+
+	// Return response
+	return &response, nil
+
+}
@@ -0,0 +1,102 @@
+package minipool
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rocket-pool/rocketpool-go/minipool"
+	"github.com/rocket-pool/rocketpool-go/settings/trustednode"
+	rptypes "github.com/rocket-pool/rocketpool-go/types"
+	"github.com/urfave/cli"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+	rputils "github.com/rocket-pool/smartnode/shared/utils/rp"
+)
+
+func getMinipoolStakeTime(c *cli.Context, minipoolAddress common.Address) (*api.MinipoolStakeTimeResponse, error) {
+
+	// Get services
+	if err := services.RequireNodeRegistered(c); err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	rp, err := services.GetRocketPool(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.MinipoolStakeTimeResponse{}
+
+	// Create minipool
+	mp, err := minipool.NewMinipool(rp, minipoolAddress, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate minipool owner
+	nodeAccount, err := w.GetNodeAccount()
+	if err != nil {
+		return nil, err
+	}
+	if err := validateMinipoolOwner(mp, nodeAccount.Address); err != nil {
+		return nil, err
+	}
+
+	// Data
+	var wg errgroup.Group
+	var status minipool.StatusDetails
+	var scrubPeriodSeconds uint64
+	var latestBlockTime time.Time
+
+	// Get data
+	wg.Go(func() error {
+		var err error
+		status, err = mp.GetStatusDetails(nil)
+		return err
+	})
+	wg.Go(func() error {
+		var err error
+		scrubPeriodSeconds, err = trustednode.GetScrubPeriod(rp, nil)
+		return err
+	})
+	wg.Go(func() error {
+		latestEth1Block, err := rp.Client.HeaderByNumber(context.Background(), nil)
+		if err != nil {
+			return fmt.Errorf("Can't get the latest block time: %w", err)
+		}
+		latestBlockTime = time.Unix(int64(latestEth1Block.Time), 0)
+		return nil
+	})
+
+	// Wait for data
+	if err := wg.Wait(); err != nil {
+		return nil, err
+	}
+
+	// Only regular prelaunch minipools are staked; vacant ones are promoted instead
+	if status.Status != rptypes.Prelaunch {
+		return nil, fmt.Errorf("Minipool %s is not in prelaunch (its status is %s).", minipoolAddress.Hex(), status.Status.String())
+	}
+	if status.IsVacant {
+		return nil, fmt.Errorf("Minipool %s is vacant, so it must be promoted instead of staked.", minipoolAddress.Hex())
+	}
+
+	// Get the earliest stake time, which is now if the scrub period has already passed
+	scrubPeriod := time.Duration(scrubPeriodSeconds) * time.Second
+	response.ScrubPeriod = scrubPeriod
+	response.TimeUntilStake = rputils.GetTimeUntil(status.StatusTime.Add(scrubPeriod), latestBlockTime)
+	response.StakeTime = latestBlockTime.Add(response.TimeUntilStake)
+	response.CanStakeNow = (response.TimeUntilStake == 0)
+
+	// Return response
+	return &response, nil
+
+}
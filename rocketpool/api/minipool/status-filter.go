@@ -0,0 +1,88 @@
+package minipool
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/rocket-pool/rocketpool-go/types"
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/services/state"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+)
+
+func getNodeMinipoolsByStatus(c *cli.Context, statuses []types.MinipoolStatus) (*api.GetNodeMinipoolsByStatusResponse, error) {
+
+	// Get services
+	if err := services.RequireNodeRegistered(c); err != nil {
+		return nil, err
+	}
+	if err := services.RequireBeaconClientSynced(c); err != nil {
+		return nil, err
+	}
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	rp, err := services.GetRocketPool(c)
+	if err != nil {
+		return nil, err
+	}
+	bc, err := services.GetBeaconClient(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.GetNodeMinipoolsByStatusResponse{
+		Minipools: []api.MinipoolStatusSummary{},
+	}
+
+	// Get node account
+	nodeAccount, err := w.GetNodeAccount()
+	if err != nil {
+		return nil, err
+	}
+
+	// Get the state
+	m, err := state.NewNetworkStateManager(rp, cfg, rp.Client, bc, nil)
+	if err != nil {
+		return nil, err
+	}
+	networkState, _, err := m.GetHeadStateForNode(nodeAccount.Address, false)
+	if err != nil {
+		return nil, fmt.Errorf("error getting network state: %w", err)
+	}
+
+	// Keep the minipools in any of the requested statuses
+	requested := map[types.MinipoolStatus]bool{}
+	for _, status := range statuses {
+		requested[status] = true
+	}
+	for _, mpd := range networkState.MinipoolDetailsByNode[nodeAccount.Address] {
+		if !requested[mpd.Status] {
+			continue
+		}
+		response.Minipools = append(response.Minipools, api.MinipoolStatusSummary{
+			Address:            mpd.MinipoolAddress,
+			Pubkey:             mpd.Pubkey,
+			Status:             mpd.Status,
+			StatusTime:         time.Unix(mpd.StatusTime.Int64(), 0),
+			DepositType:        mpd.DepositType,
+			NodeFee:            big.NewInt(0).Set(mpd.NodeFee),
+			NodeDepositBalance: big.NewInt(0).Set(mpd.NodeDepositBalance),
+			IsVacant:           mpd.IsVacant,
+			Finalised:          mpd.Finalised,
+		})
+	}
+
+	// Return response
+	return &response, nil
+
+}
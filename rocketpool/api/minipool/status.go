@@ -3,6 +3,7 @@ package minipool
 import (
 	"fmt"
 
+	"github.com/rocket-pool/rocketpool-go/network"
 	"github.com/urfave/cli"
 
 	"github.com/rocket-pool/smartnode/shared/services"
@@ -59,6 +60,12 @@ func getStatus(c *cli.Context) (*api.MinipoolStatusResponse, error) {
 
 	response.LatestDelegate = *delegate.Address
 
+	// Get the current network node fee, so the minipools' locked fees can be compared to it
+	response.NetworkNodeFee, err = network.GetNodeFee(rp, nil)
+	if err != nil {
+		return nil, fmt.Errorf("Error getting network node fee: %w", err)
+	}
+
 	// Return response
 	return &response, nil
 
@@ -0,0 +1,207 @@
+package minipool
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rocket-pool/rocketpool-go/minipool"
+	"github.com/rocket-pool/rocketpool-go/rocketpool"
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/services/config"
+	"github.com/rocket-pool/smartnode/shared/services/wallet"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+	"github.com/rocket-pool/smartnode/shared/utils/eth1"
+)
+
+func canUpgradeDelegates(c *cli.Context, minipoolAddresses []common.Address) (*api.CanUpgradeDelegatesResponse, error) {
+
+	// Get services
+	if err := services.RequireNodeRegistered(c); err != nil {
+		return nil, err
+	}
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	rp, err := services.GetRocketPool(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.CanUpgradeDelegatesResponse{}
+
+	// Check each minipool
+	response.LatestDelegateAddress, response.LatestDelegateVersion, response.Details, err = getBatchDelegateUpgradeDetails(rp, cfg, w, minipoolAddresses)
+	if err != nil {
+		return nil, err
+	}
+
+	// Sum the gas of the minipools that can be upgraded
+	for _, minipoolDetails := range response.Details {
+		if !minipoolDetails.CanUpgrade {
+			continue
+		}
+		response.CanUpgrade = true
+		response.GasInfo.EstGasLimit += minipoolDetails.GasInfo.EstGasLimit
+		response.GasInfo.SafeGasLimit += minipoolDetails.GasInfo.SafeGasLimit
+	}
+
+	// Return response
+	return &response, nil
+
+}
+
+func upgradeDelegates(c *cli.Context, minipoolAddresses []common.Address) (*api.UpgradeDelegatesResponse, error) {
+
+	// Get services
+	if err := services.RequireNodeRegistered(c); err != nil {
+		return nil, err
+	}
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	rp, err := services.GetRocketPool(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.UpgradeDelegatesResponse{}
+
+	// Check each minipool again, since the latest delegate may have changed since the check
+	response.LatestDelegateAddress, _, response.Details, err = getBatchDelegateUpgradeDetails(rp, cfg, w, minipoolAddresses)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get transactor
+	opts, err := w.GetNodeAccountTransactor()
+	if err != nil {
+		return nil, err
+	}
+
+	// Override the provided pending TX if requested; the following transactions use the nonces after it
+	err = eth1.CheckForNonceOverride(c, opts)
+	if err != nil {
+		return nil, fmt.Errorf("Error checking for nonce override: %w", err)
+	}
+
+	// Upgrade each minipool's delegate; a failure on one minipool is recorded against it so the hashes of the
+	// transactions that were already sent are still returned
+	for i := range response.Details {
+		minipoolDetails := &response.Details[i]
+		if !minipoolDetails.CanUpgrade {
+			continue
+		}
+		hash, err := upgradeMinipoolDelegate(rp, minipoolDetails, opts)
+		if err != nil {
+			minipoolDetails.Error = err.Error()
+			continue
+		}
+		minipoolDetails.TxHash = hash
+		response.TxHashes = append(response.TxHashes, hash)
+		if opts.Nonce != nil {
+			opts.Nonce = big.NewInt(0).Add(opts.Nonce, big.NewInt(1))
+		}
+	}
+
+	// Return response
+	return &response, nil
+
+}
+
+// Send the delegate upgrade transaction for a single minipool
+func upgradeMinipoolDelegate(rp *rocketpool.RocketPool, minipoolDetails *api.MinipoolBatchDelegateUpgradeDetails, opts *bind.TransactOpts) (common.Hash, error) {
+	mp, err := minipool.NewMinipool(rp, minipoolDetails.Address, nil)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	opts.GasLimit = minipoolDetails.GasInfo.SafeGasLimit
+	hash, err := mp.DelegateUpgrade(opts)
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("error upgrading the delegate of minipool %s: %w", minipoolDetails.Address.Hex(), err)
+	}
+	return hash, nil
+}
+
+// Check whether each of the provided minipools can have its delegate upgraded, and why not if it can't
+func getBatchDelegateUpgradeDetails(rp *rocketpool.RocketPool, cfg *config.RocketPoolConfig, w *wallet.Wallet, minipoolAddresses []common.Address) (common.Address, uint8, []api.MinipoolBatchDelegateUpgradeDetails, error) {
+
+	// Get node account
+	nodeAccount, err := w.GetNodeAccount()
+	if err != nil {
+		return common.Address{}, 0, nil, err
+	}
+
+	// Get the latest delegate
+	latestDelegateAddress, latestDelegateVersion, err := getLatestDelegate(rp)
+	if err != nil {
+		return common.Address{}, 0, nil, err
+	}
+
+	// Get the delegates of the node's minipools; a minipool that isn't in them doesn't belong to the node
+	nodeDetails, err := getNodeMinipoolDelegateDetails(rp, cfg, nodeAccount.Address, latestDelegateAddress)
+	if err != nil {
+		return common.Address{}, 0, nil, err
+	}
+	nodeDetailsMap := make(map[common.Address]api.MinipoolDelegateDetails, len(nodeDetails))
+	for _, mpd := range nodeDetails {
+		nodeDetailsMap[mpd.Address] = mpd
+	}
+
+	// Get transactor for the gas estimates
+	opts, err := w.GetNodeAccountTransactor()
+	if err != nil {
+		return common.Address{}, 0, nil, err
+	}
+
+	// Check each minipool
+	details := make([]api.MinipoolBatchDelegateUpgradeDetails, len(minipoolAddresses))
+	for i, address := range minipoolAddresses {
+		delegateDetails, exists := nodeDetailsMap[address]
+		if !exists {
+			return common.Address{}, 0, nil, fmt.Errorf("Minipool %s does not belong to the node", address.Hex())
+		}
+		minipoolDetails := &details[i]
+		minipoolDetails.MinipoolDelegateDetails = delegateDetails
+
+		if delegateDetails.IsLatest {
+			minipoolDetails.SkipReason = "the minipool is already using the latest delegate"
+			continue
+		}
+		if delegateDetails.Finalised {
+			minipoolDetails.SkipReason = "the minipool has been finalised"
+			continue
+		}
+
+		// The upgrade is locked if the transaction would revert
+		mp, err := minipool.NewMinipool(rp, address, nil)
+		if err != nil {
+			return common.Address{}, 0, nil, fmt.Errorf("error creating binding for minipool %s: %w", address.Hex(), err)
+		}
+		gasInfo, err := mp.EstimateDelegateUpgradeGas(opts)
+		if err != nil {
+			minipoolDetails.SkipReason = fmt.Sprintf("the upgrade cannot be performed: %s", err.Error())
+			continue
+		}
+		minipoolDetails.CanUpgrade = true
+		minipoolDetails.GasInfo = gasInfo
+	}
+
+	return latestDelegateAddress, latestDelegateVersion, details, nil
+
+}
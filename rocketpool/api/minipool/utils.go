@@ -266,12 +266,30 @@ func getMinipoolDetails(rp *rocketpool.RocketPool, minipoolAddress common.Addres
 		details.ReduceBondTime, err = minipool.GetReduceBondTime(rp, minipoolAddress, nil)
 		return err
 	})
+	var lastBondReductionTime time.Time
+	var lastBondReductionPrevNodeFee *big.Int
+	wg.Go(func() error {
+		var err error
+		lastBondReductionTime, err = minipool.GetLastBondReductionTime(rp, minipoolAddress, nil)
+		return err
+	})
+	wg.Go(func() error {
+		var err error
+		lastBondReductionPrevNodeFee, err = minipool.GetLastBondReductionPrevNodeFee(rp, minipoolAddress, nil)
+		return err
+	})
 
 	// Wait for data
 	if err := wg.Wait(); err != nil {
 		return api.MinipoolDetails{}, err
 	}
 
+	// A bond reduction replaces the node fee, so the one locked in at creation is the fee from before the last reduction
+	details.CreationNodeFee = details.Node.Fee
+	if lastBondReductionTime.Unix() > 0 && lastBondReductionPrevNodeFee.Sign() > 0 {
+		details.CreationNodeFee = eth.WeiToEth(lastBondReductionPrevNodeFee)
+	}
+
 	// Get node share of balance
 	if details.Balances.ETH.Cmp(details.Node.RefundBalance) == -1 {
 		details.NodeShareOfETHBalance = big.NewInt(0)
@@ -0,0 +1,88 @@
+package minipool
+
+import (
+	"fmt"
+
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/services/state"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+)
+
+// The prefix of withdrawal credentials that point at a BLS key instead of an address
+const blsWithdrawalPrefix byte = 0x00
+
+func getWithdrawalCredentialMismatches(c *cli.Context) (*api.GetWithdrawalCredentialMismatchesResponse, error) {
+
+	// Get services
+	if err := services.RequireNodeRegistered(c); err != nil {
+		return nil, err
+	}
+	if err := services.RequireBeaconClientSynced(c); err != nil {
+		return nil, err
+	}
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	rp, err := services.GetRocketPool(c)
+	if err != nil {
+		return nil, err
+	}
+	bc, err := services.GetBeaconClient(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.GetWithdrawalCredentialMismatchesResponse{
+		Details: []api.MinipoolWithdrawalCredentialDetails{},
+	}
+
+	// Get node account
+	nodeAccount, err := w.GetNodeAccount()
+	if err != nil {
+		return nil, err
+	}
+
+	// Get the state
+	m, err := state.NewNetworkStateManager(rp, cfg, rp.Client, bc, nil)
+	if err != nil {
+		return nil, err
+	}
+	networkState, _, err := m.GetHeadStateForNode(nodeAccount.Address, false)
+	if err != nil {
+		return nil, fmt.Errorf("error getting network state: %w", err)
+	}
+
+	// Compare each validator's withdrawal credentials with the ones its minipool expects
+	for _, mpd := range networkState.MinipoolDetailsByNode[nodeAccount.Address] {
+		validator, exists := networkState.ValidatorDetails[mpd.Pubkey]
+		if !exists || !validator.Exists {
+			// Validators that aren't on Beacon yet don't have any credentials to check
+			continue
+		}
+		details := api.MinipoolWithdrawalCredentialDetails{
+			Address:             mpd.MinipoolAddress,
+			Pubkey:              mpd.Pubkey,
+			IsVacant:            mpd.IsVacant,
+			ExpectedCredentials: mpd.WithdrawalCredentials,
+			ActualCredentials:   validator.WithdrawalCredentials,
+			UsesBlsCredentials:  validator.WithdrawalCredentials[0] == blsWithdrawalPrefix,
+		}
+		details.Mismatch = !details.UsesBlsCredentials && details.ActualCredentials != details.ExpectedCredentials
+		if details.Mismatch {
+			response.MismatchCount++
+		}
+		response.Details = append(response.Details, details)
+	}
+
+	// Return response
+	return &response, nil
+
+}
@@ -53,6 +53,78 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 				},
 			},
 
+			{
+				Name:      "rpl-stake-tiers",
+				Usage:     "Get the minimum and maximum RPL stake per minipool for each supported bond amount at the current RPL price",
+				UsageText: "rocketpool api network rpl-stake-tiers",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+
+					// Run
+					api.PrintResponse(getRplStakeTiers(c))
+					return nil
+
+				},
+			},
+
+			{
+				Name:      "rpl-inflation-info",
+				Usage:     "Get the current RPL inflation rate, the RPL minted per rewards interval, and when the next interval's inflation is distributed",
+				UsageText: "rocketpool api network rpl-inflation-info",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+
+					// Run
+					api.PrintResponse(getRplInflationInfo(c))
+					return nil
+
+				},
+			},
+
+			{
+				Name:      "protocol-status",
+				Usage:     "Get whether the protocol is deployed, and which of its deposits, minipool actions, and other gated actions are currently enabled",
+				UsageText: "rocketpool api network protocol-status",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+
+					// Run
+					api.PrintResponse(getProtocolStatus(c))
+					return nil
+
+				},
+			},
+
+			{
+				Name:      "check-settings-drift",
+				Usage:     "Compare the network values built into the Smartnode against the current on-chain values, and report the ones that differ",
+				UsageText: "rocketpool api network check-settings-drift",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+
+					// Run
+					api.PrintResponse(checkSettingsDrift(c))
+					return nil
+
+				},
+			},
+
 			{
 				Name:      "stats",
 				Aliases:   []string{"s"},
@@ -180,6 +252,32 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 				},
 			},
 
+			{
+				Name:      "get-rewards-tree-node-entry",
+				Usage:     "Get a node's entry in the official rewards tree for a past interval, including its Merkle proof",
+				UsageText: "rocketpool api network get-rewards-tree-node-entry interval node-address",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 2); err != nil {
+						return err
+					}
+					interval, err := cliutils.ValidateUint("interval", c.Args().Get(0))
+					if err != nil {
+						return err
+					}
+					nodeAddress, err := cliutils.ValidateAddress("node address", c.Args().Get(1))
+					if err != nil {
+						return err
+					}
+
+					// Run
+					api.PrintResponse(getRewardsTreeNodeEntry(c, interval, nodeAddress))
+					return nil
+
+				},
+			},
+
 			{
 				Name:      "is-atlas-deployed",
 				Aliases:   []string{"iad"},
@@ -216,6 +314,24 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 
 				},
 			},
+
+			{
+				Name:      "rewards-interval-info",
+				Usage:     "Get the index, start time, and expected end of the current rewards interval",
+				UsageText: "rocketpool api network rewards-interval-info",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+
+					// Run
+					api.PrintResponse(getRewardsIntervalInfo(c))
+					return nil
+
+				},
+			},
 		},
 	})
 }
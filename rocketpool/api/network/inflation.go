@@ -0,0 +1,90 @@
+package network
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/rocket-pool/rocketpool-go/rewards"
+	"github.com/rocket-pool/rocketpool-go/tokens"
+	"github.com/rocket-pool/rocketpool-go/utils/eth"
+	"github.com/urfave/cli"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+)
+
+// RPL inflation is minted once per day, compounding at the interval rate
+const rplInflationIntervalTime = 24 * time.Hour
+
+func getRplInflationInfo(c *cli.Context) (*api.NetworkRplInflationInfoResponse, error) {
+
+	// Get services
+	if err := services.RequireRocketStorage(c); err != nil {
+		return nil, err
+	}
+	rp, err := services.GetRocketPool(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.NetworkRplInflationInfoResponse{}
+
+	// Sync
+	var wg errgroup.Group
+	var intervalStart time.Time
+	var inflationStart time.Time
+
+	// Get data
+	wg.Go(func() error {
+		var err error
+		response.InflationIntervalRate, err = tokens.GetRPLInflationIntervalRate(rp, nil)
+		return err
+	})
+	wg.Go(func() error {
+		var err error
+		response.TotalRplSupply, err = tokens.GetRPLTotalSupply(rp, nil)
+		return err
+	})
+	wg.Go(func() error {
+		var err error
+		response.RewardsInterval, err = rewards.GetClaimIntervalTime(rp, nil)
+		return err
+	})
+	wg.Go(func() error {
+		var err error
+		intervalStart, err = rewards.GetClaimIntervalTimeStart(rp, nil)
+		return err
+	})
+	wg.Go(func() error {
+		var err error
+		inflationStart, err = tokens.GetRPLInflationIntervalStartTime(rp, nil)
+		return err
+	})
+
+	// Wait for data
+	if err := wg.Wait(); err != nil {
+		return nil, err
+	}
+
+	// The inflation minted for a rewards interval is distributed when the interval ends.
+	// Before the first interval has ended, it starts when RPL inflation started.
+	if intervalStart == time.Unix(0, 0) {
+		intervalStart = inflationStart
+	}
+	response.NextAdjustmentTime = intervalStart.Add(response.RewardsInterval)
+
+	// Compound the daily rate over the interval's days to get the RPL minted during it
+	oneEth := eth.EthToWei(1)
+	intervalSupply := big.NewInt(0).Set(response.TotalRplSupply)
+	for i := time.Duration(0); i < response.RewardsInterval/rplInflationIntervalTime; i++ {
+		intervalSupply.Mul(intervalSupply, response.InflationIntervalRate)
+		intervalSupply.Div(intervalSupply, oneEth)
+	}
+	response.IntervalInflationAmount = intervalSupply.Sub(intervalSupply, response.TotalRplSupply)
+
+	// Return response
+	return &response, nil
+
+}
@@ -0,0 +1,113 @@
+package network
+
+import (
+	"github.com/rocket-pool/rocketpool-go/settings/protocol"
+	"github.com/urfave/cli"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/services/state"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+)
+
+func getProtocolStatus(c *cli.Context) (*api.NetworkProtocolStatusResponse, error) {
+
+	// Get services
+	if err := services.RequireEthClientSynced(c); err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.NetworkProtocolStatusResponse{}
+
+	// Nothing is enabled if the protocol hasn't been deployed on this network yet
+	isDeployed, err := services.IsRocketStorageDeployed(c)
+	if err != nil {
+		return nil, err
+	}
+	response.IsDeployed = isDeployed
+	if !response.IsDeployed {
+		return &response, nil
+	}
+	rp, err := services.GetRocketPool(c)
+	if err != nil {
+		return nil, err
+	}
+	response.IsAtlasDeployed, err = state.IsAtlasDeployed(rp, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// Sync
+	var wg errgroup.Group
+
+	// Get data
+	wg.Go(func() error {
+		var err error
+		response.DepositEnabled, err = protocol.GetDepositEnabled(rp, nil)
+		return err
+	})
+	wg.Go(func() error {
+		var err error
+		response.AssignDepositsEnabled, err = protocol.GetAssignDepositsEnabled(rp, nil)
+		return err
+	})
+	wg.Go(func() error {
+		var err error
+		response.NodeRegistrationEnabled, err = protocol.GetNodeRegistrationEnabled(rp, nil)
+		return err
+	})
+	wg.Go(func() error {
+		var err error
+		response.NodeDepositEnabled, err = protocol.GetNodeDepositEnabled(rp, nil)
+		return err
+	})
+	wg.Go(func() error {
+		var err error
+		response.MinipoolSubmitWithdrawableEnabled, err = protocol.GetMinipoolSubmitWithdrawableEnabled(rp, nil)
+		return err
+	})
+	wg.Go(func() error {
+		var err error
+		response.SubmitBalancesEnabled, err = protocol.GetSubmitBalancesEnabled(rp, nil)
+		return err
+	})
+	wg.Go(func() error {
+		var err error
+		response.SubmitPricesEnabled, err = protocol.GetSubmitPricesEnabled(rp, nil)
+		return err
+	})
+	wg.Go(func() error {
+		var err error
+		response.CreateLotEnabled, err = protocol.GetCreateLotEnabled(rp, nil)
+		return err
+	})
+	wg.Go(func() error {
+		var err error
+		response.BidOnLotEnabled, err = protocol.GetBidOnLotEnabled(rp, nil)
+		return err
+	})
+
+	// These settings were added in Atlas
+	if response.IsAtlasDeployed {
+		wg.Go(func() error {
+			var err error
+			response.VacantMinipoolsEnabled, err = protocol.GetVacantMinipoolsEnabled(rp, nil)
+			return err
+		})
+		wg.Go(func() error {
+			var err error
+			response.BondReductionEnabled, err = protocol.GetBondReductionEnabled(rp, nil)
+			return err
+		})
+	}
+
+	// Wait for data
+	if err := wg.Wait(); err != nil {
+		return nil, err
+	}
+
+	// Return response
+	return &response, nil
+
+}
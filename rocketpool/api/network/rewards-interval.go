@@ -0,0 +1,92 @@
+package network
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/rocket-pool/rocketpool-go/rewards"
+	"github.com/rocket-pool/rocketpool-go/storage"
+	"github.com/urfave/cli"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/rocket-pool/smartnode/shared/services"
+	rprewards "github.com/rocket-pool/smartnode/shared/services/rewards"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+)
+
+func getRewardsIntervalInfo(c *cli.Context) (*api.RewardsIntervalInfoResponse, error) {
+
+	// Get services
+	if err := services.RequireRocketStorage(c); err != nil {
+		return nil, err
+	}
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	rp, err := services.GetRocketPool(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.RewardsIntervalInfoResponse{}
+
+	// Sync
+	var wg errgroup.Group
+
+	// Get data
+	wg.Go(func() error {
+		index, err := rewards.GetRewardIndex(rp, nil)
+		if err == nil {
+			response.Index = index.Uint64()
+		}
+		return err
+	})
+	wg.Go(func() error {
+		startTime, err := rewards.GetClaimIntervalTimeStart(rp, nil)
+		if err == nil {
+			response.StartTime = startTime
+		}
+		return err
+	})
+	wg.Go(func() error {
+		intervalTime, err := rewards.GetClaimIntervalTime(rp, nil)
+		if err == nil {
+			response.IntervalTime = intervalTime
+		}
+		return err
+	})
+
+	// Wait for data
+	if err := wg.Wait(); err != nil {
+		return nil, err
+	}
+
+	// Get the interval's start block from the end of the previous interval
+	if response.Index == 0 {
+		deployBlock, err := storage.GetDeployBlock(rp)
+		if err != nil {
+			return nil, fmt.Errorf("error getting Rocket Pool deployment block: %w", err)
+		}
+		response.StartBlock = deployBlock.Uint64()
+	} else {
+		event, err := rprewards.GetRewardSnapshotEvent(rp, cfg, response.Index-1, nil)
+		if err != nil {
+			return nil, err
+		}
+		response.StartBlock = big.NewInt(0).Add(event.ExecutionBlock, big.NewInt(1)).Uint64()
+	}
+
+	// Calculate the expected end and time remaining
+	response.EndTime = response.StartTime.Add(response.IntervalTime)
+	response.TimeRemaining = time.Until(response.EndTime)
+	if response.TimeRemaining < 0 {
+		response.TimeRemaining = 0
+	}
+
+	// Return response
+	return &response, nil
+
+}
@@ -0,0 +1,81 @@
+package network
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	rprewards "github.com/rocket-pool/rocketpool-go/rewards"
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/services/rewards"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+)
+
+func getRewardsTreeNodeEntry(c *cli.Context, interval uint64, nodeAddress common.Address) (*api.NetworkRewardsTreeNodeEntryResponse, error) {
+
+	// Get services
+	if err := services.RequireRocketStorage(c); err != nil {
+		return nil, err
+	}
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	rp, err := services.GetRocketPool(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.NetworkRewardsTreeNodeEntryResponse{
+		Interval:    interval,
+		NodeAddress: nodeAddress,
+	}
+
+	// Make sure the interval has been published
+	currentIndex, err := rprewards.GetRewardIndex(rp, nil)
+	if err != nil {
+		return nil, err
+	}
+	if currentIndex.Uint64() == 0 {
+		return nil, fmt.Errorf("No rewards intervals have finished yet.")
+	}
+	if interval >= currentIndex.Uint64() {
+		return nil, fmt.Errorf("Interval %d has not finished yet; the latest finished interval is %d.", interval, currentIndex.Uint64()-1)
+	}
+
+	// Get the interval info, downloading the official tree file if it isn't on disk yet
+	intervalInfo, err := rewards.GetIntervalInfo(rp, cfg, nodeAddress, interval, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error getting interval %d info: %w", interval, err)
+	}
+	if !intervalInfo.TreeFileExists {
+		err = intervalInfo.DownloadRewardsFile(cfg, true)
+		if err != nil {
+			return nil, fmt.Errorf("The rewards tree file for interval %d could not be downloaded; you can regenerate it with 'rocketpool network generate-rewards-tree' instead. Error: %w", interval, err)
+		}
+		intervalInfo, err = rewards.GetIntervalInfo(rp, cfg, nodeAddress, interval, nil)
+		if err != nil {
+			return nil, fmt.Errorf("error getting interval %d info: %w", interval, err)
+		}
+	}
+	response.MerkleRoot = intervalInfo.MerkleRoot
+	if !intervalInfo.MerkleRootValid {
+		return nil, fmt.Errorf("The Merkle root of the rewards tree file for interval %d (%s) does not match the canonical one (%s).", interval, intervalInfo.TreeFilePath, intervalInfo.MerkleRoot.Hex())
+	}
+	if !intervalInfo.NodeExists {
+		return nil, fmt.Errorf("Node %s is not in the rewards tree for interval %d.", nodeAddress.Hex(), interval)
+	}
+
+	// Get the node's entry
+	response.CollateralRplAmount = big.NewInt(0).Set(&intervalInfo.CollateralRplAmount.Int)
+	response.ODaoRplAmount = big.NewInt(0).Set(&intervalInfo.ODaoRplAmount.Int)
+	response.SmoothingPoolEthAmount = big.NewInt(0).Set(&intervalInfo.SmoothingPoolEthAmount.Int)
+	response.MerkleProof = intervalInfo.MerkleProof
+
+	// Return response
+	return &response, nil
+
+}
@@ -11,6 +11,7 @@ import (
 
 	"github.com/rocket-pool/smartnode/shared/services"
 	"github.com/rocket-pool/smartnode/shared/types/api"
+	rputils "github.com/rocket-pool/smartnode/shared/utils/rp"
 )
 
 func getRplPrice(c *cli.Context) (*api.RplPriceResponse, error) {
@@ -30,8 +31,6 @@ func getRplPrice(c *cli.Context) (*api.RplPriceResponse, error) {
 	// Data
 	var wg errgroup.Group
 	var rplPrice *big.Int
-	_24Eth := eth.EthToWei(24)
-	_16Eth := eth.EthToWei(16)
 	var minPerMinipoolStake *big.Int
 
 	// Get RPL price set block
@@ -61,18 +60,10 @@ func getRplPrice(c *cli.Context) (*api.RplPriceResponse, error) {
 	}
 
 	// Min for LEB8s
-	minPer8EthMinipoolRplStake := big.NewInt(0)
-	minPer8EthMinipoolRplStake.Mul(_24Eth, minPerMinipoolStake) // Min is 10% of borrowed (24 ETH)
-	minPer8EthMinipoolRplStake.Div(minPer8EthMinipoolRplStake, rplPrice)
-	minPer8EthMinipoolRplStake.Add(minPer8EthMinipoolRplStake, big.NewInt(1))
-	response.MinPer8EthMinipoolRplStake = minPer8EthMinipoolRplStake
+	response.MinPer8EthMinipoolRplStake = rputils.GetMinimumPerMinipoolRplStake(eth.EthToWei(8), minPerMinipoolStake, rplPrice)
 
 	// Min for 16s
-	minPer16EthMinipoolRplStake := big.NewInt(0)
-	minPer16EthMinipoolRplStake.Mul(_16Eth, minPerMinipoolStake) // Min is 10% of borrowed (16 ETH)
-	minPer16EthMinipoolRplStake.Div(minPer16EthMinipoolRplStake, rplPrice)
-	minPer16EthMinipoolRplStake.Add(minPer16EthMinipoolRplStake, big.NewInt(1))
-	response.MinPer16EthMinipoolRplStake = minPer16EthMinipoolRplStake
+	response.MinPer16EthMinipoolRplStake = rputils.GetMinimumPerMinipoolRplStake(eth.EthToWei(16), minPerMinipoolStake, rplPrice)
 
 	// Update & return response
 	response.RplPrice = rplPrice
@@ -0,0 +1,77 @@
+package network
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/rocket-pool/rocketpool-go/network"
+	"github.com/rocket-pool/rocketpool-go/settings/protocol"
+	"github.com/rocket-pool/rocketpool-go/utils/eth"
+	"github.com/urfave/cli"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+	rputils "github.com/rocket-pool/smartnode/shared/utils/rp"
+)
+
+func getRplStakeTiers(c *cli.Context) (*api.NetworkRplStakeTiersResponse, error) {
+
+	// Get services
+	if err := services.RequireRocketStorage(c); err != nil {
+		return nil, err
+	}
+	rp, err := services.GetRocketPool(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.NetworkRplStakeTiersResponse{}
+
+	// Data
+	var wg errgroup.Group
+	var minStakeFraction *big.Int
+	var maxStakeFraction *big.Int
+
+	// Get data
+	wg.Go(func() error {
+		var err error
+		response.RplPrice, err = network.GetRPLPrice(rp, nil)
+		return err
+	})
+	wg.Go(func() error {
+		var err error
+		minStakeFraction, err = protocol.GetMinimumPerMinipoolStakeRaw(rp, nil)
+		return err
+	})
+	wg.Go(func() error {
+		var err error
+		maxStakeFraction, err = protocol.GetMaximumPerMinipoolStakeRaw(rp, nil)
+		return err
+	})
+
+	// Wait for data
+	if err := wg.Wait(); err != nil {
+		return nil, err
+	}
+
+	if response.RplPrice.Sign() == 0 {
+		return nil, fmt.Errorf("The RPL price has not been set yet.")
+	}
+
+	// The minimum is a fraction of the borrowed ETH and the maximum is a fraction of the bonded ETH, both in RPL
+	for _, bondAmount := range rputils.SupportedBondAmounts {
+		bond := eth.EthToWei(bondAmount)
+		response.Tiers = append(response.Tiers, api.RplStakeTier{
+			BondAmount:      bond,
+			BorrowedAmount:  rputils.GetMinipoolBorrowedEth(bond),
+			MinimumRplStake: rputils.GetMinimumPerMinipoolRplStake(bond, minStakeFraction, response.RplPrice),
+			MaximumRplStake: rputils.GetMaximumPerMinipoolRplStake(bond, maxStakeFraction, response.RplPrice),
+		})
+	}
+
+	// Return response
+	return &response, nil
+
+}
@@ -0,0 +1,62 @@
+package network
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+)
+
+func checkSettingsDrift(c *cli.Context) (*api.NetworkSettingsDriftResponse, error) {
+
+	// Get services
+	if err := services.RequireRocketStorage(c); err != nil {
+		return nil, err
+	}
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	rp, err := services.GetRocketPool(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.NetworkSettingsDriftResponse{
+		DriftedSettings: []api.SettingDrift{},
+	}
+
+	// The contract addresses the Smartnode has built in for the network, by their name in RocketStorage
+	configuredAddresses := []struct {
+		setting      string
+		contractName string
+		address      common.Address
+	}{
+		{"RPL token address", "rocketTokenRPL", common.HexToAddress(cfg.Smartnode.GetRplTokenAddress())},
+		{"rETH address", "rocketTokenRETH", cfg.Smartnode.GetRethAddress()},
+	}
+
+	// Compare them against the current on-chain addresses
+	for _, configured := range configuredAddresses {
+		onChainAddress, err := rp.GetAddress(configured.contractName, nil)
+		if err != nil {
+			return nil, fmt.Errorf("error getting the on-chain address of %s: %w", configured.contractName, err)
+		}
+		response.CheckedSettings++
+		if *onChainAddress != configured.address {
+			response.DriftedSettings = append(response.DriftedSettings, api.SettingDrift{
+				Name:            configured.setting,
+				ConfiguredValue: configured.address.Hex(),
+				OnChainValue:    onChainAddress.Hex(),
+			})
+		}
+	}
+
+	// Return response
+	return &response, nil
+
+}
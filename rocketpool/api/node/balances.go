@@ -3,10 +3,17 @@ package node
 import (
 	"context"
 	"fmt"
+	"math/big"
 
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rocket-pool/rocketpool-go/node"
+	"github.com/rocket-pool/rocketpool-go/storage"
+	"github.com/rocket-pool/rocketpool-go/tokens"
 	"github.com/rocket-pool/smartnode/shared/services"
 	"github.com/rocket-pool/smartnode/shared/types/api"
 	"github.com/urfave/cli"
+	"golang.org/x/sync/errgroup"
 )
 
 func getNodeEthBalance(c *cli.Context) (*api.NodeEthBalanceResponse, error) {
@@ -39,3 +46,81 @@ func getNodeEthBalance(c *cli.Context) (*api.NodeEthBalanceResponse, error) {
 
 	return &response, nil
 }
+
+func getNodeBalances(c *cli.Context) (*api.NodeBalancesResponse, error) {
+	// Get services
+	if err := services.RequireNodeWallet(c); err != nil {
+		return nil, err
+	}
+	if err := services.RequireRocketStorage(c); err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	rp, err := services.GetRocketPool(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.NodeBalancesResponse{}
+
+	// Get node account
+	nodeAccount, err := w.GetNodeAccount()
+	if err != nil {
+		return nil, err
+	}
+
+	// Query everything at the same block so the balances are consistent with each other
+	blockNumber, err := rp.Client.BlockNumber(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("error getting the latest block number: %w", err)
+	}
+	opts := &bind.CallOpts{
+		BlockNumber: big.NewInt(0).SetUint64(blockNumber),
+	}
+
+	// Data
+	var wg errgroup.Group
+	var accountBalances tokens.Balances
+
+	// Get data
+	wg.Go(func() error {
+		var err error
+		accountBalances, err = tokens.GetBalances(rp, nodeAccount.Address, opts)
+		return err
+	})
+	wg.Go(func() error {
+		var err error
+		response.RplStake, err = node.GetNodeRPLStake(rp, nodeAccount.Address, opts)
+		return err
+	})
+	wg.Go(func() error {
+		var err error
+		response.WithdrawalAddress, err = storage.GetNodeWithdrawalAddress(rp, nodeAccount.Address, opts)
+		return err
+	})
+
+	// Wait for data
+	if err := wg.Wait(); err != nil {
+		return nil, err
+	}
+	response.EthBalance = accountBalances.ETH
+	response.RplBalance = accountBalances.RPL
+
+	// Get the withdrawal address balances if it's separate from the node address
+	if response.WithdrawalAddress != nodeAccount.Address && response.WithdrawalAddress != (common.Address{}) {
+		withdrawalBalances, err := tokens.GetBalances(rp, response.WithdrawalAddress, opts)
+		if err != nil {
+			return nil, fmt.Errorf("error getting balances of withdrawal address %s: %w", response.WithdrawalAddress.Hex(), err)
+		}
+		response.HasSeparateWithdrawalAddress = true
+		response.WithdrawalEthBalance = withdrawalBalances.ETH
+		response.WithdrawalRplBalance = withdrawalBalances.RPL
+	}
+
+	// Return response
+	return &response, nil
+}
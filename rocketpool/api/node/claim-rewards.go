@@ -201,6 +201,7 @@ func canClaimRewards(c *cli.Context, indicesString string) (*api.CanNodeClaimRew
 	if err != nil {
 		return nil, err
 	}
+	response.TotalRpl, response.TotalEth = getRewardTotals(amountRPL, amountETH)
 
 	// Get gas estimate
 	opts, err := w.GetNodeAccountTransactor()
@@ -249,6 +250,7 @@ func claimRewards(c *cli.Context, indicesString string) (*api.NodeClaimRewardsRe
 	if err != nil {
 		return nil, err
 	}
+	response.TotalRpl, response.TotalEth = getRewardTotals(amountRPL, amountETH)
 
 	// Get transactor
 	opts, err := w.GetNodeAccountTransactor()
@@ -307,6 +309,12 @@ func canClaimAndStakeRewards(c *cli.Context, indicesString string, stakeAmount *
 	if err != nil {
 		return nil, err
 	}
+	response.TotalRpl, response.TotalEth = getRewardTotals(amountRPL, amountETH)
+
+	// Only claimed RPL can be restaked
+	if stakeAmount.Cmp(response.TotalRpl) > 0 {
+		return nil, fmt.Errorf("Cannot restake more RPL than is being claimed (%s wei).", response.TotalRpl.String())
+	}
 
 	// Get gas estimate
 	opts, err := w.GetNodeAccountTransactor()
@@ -361,6 +369,12 @@ func claimAndStakeRewards(c *cli.Context, indicesString string, stakeAmount *big
 	if err != nil {
 		return nil, err
 	}
+	response.TotalRpl, response.TotalEth = getRewardTotals(amountRPL, amountETH)
+
+	// Only claimed RPL can be restaked
+	if stakeAmount.Cmp(response.TotalRpl) > 0 {
+		return nil, fmt.Errorf("Cannot restake more RPL than is being claimed (%s wei).", response.TotalRpl.String())
+	}
 
 	// Override the provided pending TX if requested
 	err = eth1.CheckForNonceOverride(c, opts)
@@ -380,7 +394,7 @@ func claimAndStakeRewards(c *cli.Context, indicesString string, stakeAmount *big
 
 }
 
-// Get the rewards for the provided interval indices
+// Get the rewards for the provided interval indices, making sure each one is unclaimed and has a proof for the node
 func getRewardsForIntervals(rp *rocketpool.RocketPool, cfg *config.RocketPoolConfig, nodeAddress common.Address, indicesString string) ([]*big.Int, []*big.Int, []*big.Int, [][]common.Hash, error) {
 
 	// Get the indices
@@ -405,9 +419,22 @@ func getRewardsForIntervals(rp *rocketpool.RocketPool, cfg *config.RocketPoolCon
 	amountETH := []*big.Int{}
 	merkleProofs := [][]common.Hash{}
 
+	// Get the intervals that haven't been claimed yet
+	unclaimed, _, err := rprewards.GetClaimStatus(rp, nodeAddress)
+	if err != nil {
+		return nil, nil, nil, nil, err
+	}
+	unclaimedIntervals := map[uint64]bool{}
+	for _, interval := range unclaimed {
+		unclaimedIntervals[interval] = true
+	}
+
 	// Populate the interval info for each one
 	for _, index := range indices {
 
+		if !unclaimedIntervals[index.Uint64()] {
+			return nil, nil, nil, nil, fmt.Errorf("interval %d has already been claimed or hasn't finished yet", index.Uint64())
+		}
 		intervalInfo, err := rprewards.GetIntervalInfo(rp, cfg, nodeAddress, index.Uint64(), nil)
 		if err != nil {
 			return nil, nil, nil, nil, err
@@ -420,23 +447,40 @@ func getRewardsForIntervals(rp *rocketpool.RocketPool, cfg *config.RocketPoolCon
 		if !intervalInfo.MerkleRootValid {
 			return nil, nil, nil, nil, fmt.Errorf("merkle root for rewards tree file '%s' doesn't match the canonical merkle root for interval %d", intervalInfo.TreeFilePath, index.Uint64())
 		}
+		if !intervalInfo.NodeExists {
+			return nil, nil, nil, nil, fmt.Errorf("node %s has no rewards for interval %d", nodeAddress.Hex(), index.Uint64())
+		}
+		if len(intervalInfo.MerkleProof) == 0 {
+			return nil, nil, nil, nil, fmt.Errorf("rewards tree file '%s' doesn't have a merkle proof for node %s", intervalInfo.TreeFilePath, nodeAddress.Hex())
+		}
 
 		// Get the rewards from it
-		if intervalInfo.NodeExists {
-			rplForInterval := big.NewInt(0)
-			rplForInterval.Add(rplForInterval, &intervalInfo.CollateralRplAmount.Int)
-			rplForInterval.Add(rplForInterval, &intervalInfo.ODaoRplAmount.Int)
+		rplForInterval := big.NewInt(0)
+		rplForInterval.Add(rplForInterval, &intervalInfo.CollateralRplAmount.Int)
+		rplForInterval.Add(rplForInterval, &intervalInfo.ODaoRplAmount.Int)
 
-			ethForInterval := big.NewInt(0)
-			ethForInterval.Add(ethForInterval, &intervalInfo.SmoothingPoolEthAmount.Int)
+		ethForInterval := big.NewInt(0)
+		ethForInterval.Add(ethForInterval, &intervalInfo.SmoothingPoolEthAmount.Int)
 
-			amountRPL = append(amountRPL, rplForInterval)
-			amountETH = append(amountETH, ethForInterval)
-			merkleProofs = append(merkleProofs, intervalInfo.MerkleProof)
-		}
+		amountRPL = append(amountRPL, rplForInterval)
+		amountETH = append(amountETH, ethForInterval)
+		merkleProofs = append(merkleProofs, intervalInfo.MerkleProof)
 	}
 
 	// Return
 	return indices, amountRPL, amountETH, merkleProofs, nil
 
 }
+
+// Get the total RPL and ETH being claimed across a set of intervals
+func getRewardTotals(amountRPL []*big.Int, amountETH []*big.Int) (*big.Int, *big.Int) {
+	totalRPL := big.NewInt(0)
+	for _, amount := range amountRPL {
+		totalRPL.Add(totalRPL, amount)
+	}
+	totalETH := big.NewInt(0)
+	for _, amount := range amountETH {
+		totalETH.Add(totalETH, amount)
+	}
+	return totalRPL, totalETH
+}
@@ -0,0 +1,148 @@
+package node
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/rocket-pool/rocketpool-go/network"
+	"github.com/rocket-pool/rocketpool-go/node"
+	"github.com/rocket-pool/rocketpool-go/rocketpool"
+	"github.com/rocket-pool/rocketpool-go/utils/eth"
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/services/beacon"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+	rputils "github.com/rocket-pool/smartnode/shared/utils/rp"
+)
+
+// Get the node's borrowed collateral ratio now and at a previous slot
+func getCollateralRatioTrend(c *cli.Context, previousSlot uint64) (*api.NodeCollateralRatioTrendResponse, error) {
+
+	// Get services
+	if err := services.RequireNodeRegistered(c); err != nil {
+		return nil, err
+	}
+	if err := services.RequireBeaconClientSynced(c); err != nil {
+		return nil, err
+	}
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	rp, err := services.GetRocketPool(c)
+	if err != nil {
+		return nil, err
+	}
+	bc, err := services.GetBeaconClient(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.NodeCollateralRatioTrendResponse{}
+
+	// The previous ratio requires historical state
+	archiveEcUrl := cfg.Smartnode.ArchiveECUrl.Value.(string)
+	if archiveEcUrl == "" {
+		return nil, fmt.Errorf("Getting the node's collateral ratio trend requires an Archive EC, but one is not specified.")
+	}
+
+	// Get node account
+	nodeAccount, err := w.GetNodeAccount()
+	if err != nil {
+		return nil, err
+	}
+
+	// Get the first proposed block at or before the previous slot
+	head, err := bc.GetBeaconHead()
+	if err != nil {
+		return nil, err
+	}
+	eth2Config, err := bc.GetEth2Config()
+	if err != nil {
+		return nil, err
+	}
+	if previousSlot >= (head.Epoch+1)*eth2Config.SlotsPerEpoch {
+		return nil, fmt.Errorf("Slot %d is in the future.", previousSlot)
+	}
+	slot := previousSlot
+	var block beacon.BeaconBlock
+	for {
+		var exists bool
+		block, exists, err = bc.GetBeaconBlock(fmt.Sprint(slot))
+		if err != nil {
+			return nil, fmt.Errorf("error getting Beacon block %d: %w", slot, err)
+		}
+		if exists {
+			break
+		}
+		if slot == 0 {
+			return nil, fmt.Errorf("No Beacon block was found at or before slot %d.", previousSlot)
+		}
+		slot--
+	}
+	response.PreviousSlot = slot
+	response.PreviousBlock = block.ExecutionBlockNumber
+
+	// Get the current ratio
+	response.CurrentRatio, err = getBorrowedCollateralRatio(rp, nodeAccount.Address, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// Connect to the Archive EC and get the previous ratio
+	ec, err := ethclient.Dial(archiveEcUrl)
+	if err != nil {
+		return nil, fmt.Errorf("Error connecting to archive EC: %w", err)
+	}
+	defer ec.Close()
+	archiveRp, err := rocketpool.NewRocketPool(ec, common.HexToAddress(cfg.Smartnode.GetStorageAddress()))
+	if err != nil {
+		return nil, fmt.Errorf("Error creating Rocket Pool client connected to archive EC: %w", err)
+	}
+	response.PreviousRatio, err = getBorrowedCollateralRatio(archiveRp, nodeAccount.Address, &bind.CallOpts{
+		BlockNumber: big.NewInt(0).SetUint64(block.ExecutionBlockNumber),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error getting collateral ratio at block %d: %w", block.ExecutionBlockNumber, err)
+	}
+
+	// The delta is only meaningful if the node had borrowed ETH at both points
+	if response.CurrentRatio >= 0 && response.PreviousRatio >= 0 {
+		response.Delta = response.CurrentRatio - response.PreviousRatio
+	}
+
+	// Return response
+	return &response, nil
+
+}
+
+// Get the value of the node's staked RPL divided by the ETH it has borrowed, or -1 if it hasn't borrowed any
+func getBorrowedCollateralRatio(rp *rocketpool.RocketPool, nodeAddress common.Address, opts *bind.CallOpts) (float64, error) {
+	rplPrice, err := network.GetRPLPrice(rp, opts)
+	if err != nil {
+		return 0, fmt.Errorf("error getting RPL price: %w", err)
+	}
+	rplStake, err := node.GetNodeRPLStake(rp, nodeAddress, opts)
+	if err != nil {
+		return 0, fmt.Errorf("error getting RPL stake: %w", err)
+	}
+	ethMatched, _, pendingMatchAmount, err := rputils.CheckCollateral(rp, nodeAddress, opts)
+	if err != nil {
+		return 0, fmt.Errorf("error getting borrowed ETH: %w", err)
+	}
+
+	borrowedEth := eth.WeiToEth(ethMatched) + eth.WeiToEth(pendingMatchAmount)
+	if borrowedEth == 0 {
+		return -1, nil
+	}
+	return eth.WeiToEth(rplPrice) * eth.WeiToEth(rplStake) / borrowedEth, nil
+}
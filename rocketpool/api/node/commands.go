@@ -185,6 +185,23 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 
 				},
 			},
+			{
+				Name:      "get-withdrawal-address-status",
+				Usage:     "Get the node's current withdrawal address and any pending change that is awaiting confirmation",
+				UsageText: "rocketpool api node get-withdrawal-address-status",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+
+					// Run
+					api.PrintResponse(getWithdrawalAddressStatus(c))
+					return nil
+
+				},
+			},
 
 			{
 				Name:      "can-set-timezone",
@@ -594,6 +611,27 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 				},
 			},
 
+			{
+				Name:      "get-minipool-creation-cost",
+				Usage:     "Estimate the ETH, RPL, and gas required to create a minipool with the provided bond",
+				UsageText: "rocketpool api node get-minipool-creation-cost bond-amount",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 1); err != nil {
+						return err
+					}
+					bondAmountWei, err := cliutils.ValidatePositiveWeiAmount("bond amount", c.Args().Get(0))
+					if err != nil {
+						return err
+					}
+
+					// Run
+					api.PrintResponse(getMinipoolCreationCost(c, bondAmountWei))
+					return nil
+
+				},
+			},
 			{
 				Name:      "can-deposit",
 				Usage:     "Check whether the node can make a deposit",
@@ -1059,6 +1097,23 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 
 				},
 			},
+			{
+				Name:      "get-distributor-balance-split",
+				Usage:     "Get the node's fee distributor balance and how it would be split between the node and the protocol",
+				UsageText: "rocketpool api node get-distributor-balance-split",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+
+					// Run
+					api.PrintResponse(getDistributorBalanceSplit(c))
+					return nil
+
+				},
+			},
 			{
 				Name:      "set-snapshot-delegate",
 				Usage:     "Set a voting snapshot delegate for the node",
@@ -1133,6 +1188,23 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 
 				},
 			},
+			{
+				Name:      "get-unclaimed-rewards-intervals",
+				Usage:     "Get the past rewards intervals the node hasn't claimed yet, with the RPL and ETH owed for each",
+				UsageText: "rocketpool api node get-unclaimed-rewards-intervals",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+
+					// Run
+					api.PrintResponse(getUnclaimedRewardsIntervals(c))
+					return nil
+
+				},
+			},
 			{
 				Name:      "can-claim-rewards",
 				Usage:     "Check if the rewards for the given intervals can be claimed",
@@ -1233,6 +1305,57 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 
 				},
 			},
+			{
+				Name:      "get-smoothing-pool-registration-change-time",
+				Usage:     "Get when the node last changed its Smoothing Pool status, and the earliest time it can change it again",
+				UsageText: "rocketpool api node get-smoothing-pool-registration-change-time",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+
+					// Run
+					api.PrintResponse(getSmoothingPoolRegistrationChangeTime(c))
+					return nil
+
+				},
+			},
+			{
+				Name:      "get-smoothing-pool-share",
+				Usage:     "Estimate the node's share of the current Smoothing Pool balance based on its attestation performance so far this interval",
+				UsageText: "rocketpool api node get-smoothing-pool-share",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+
+					// Run
+					api.PrintResponse(getSmoothingPoolShare(c))
+					return nil
+
+				},
+			},
+			{
+				Name:      "get-smoothing-pool-status",
+				Usage:     "Get the Smoothing Pool's balance, whether the node is opted into it, and how many of the node's minipools are eligible for the current interval",
+				UsageText: "rocketpool api node get-smoothing-pool-status",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+
+					// Run
+					api.PrintResponse(getSmoothingPoolStatus(c))
+					return nil
+
+				},
+			},
 			{
 				Name:      "can-set-smoothing-pool-status",
 				Usage:     "Check if the node's Smoothing Pool status can be changed",
@@ -1416,6 +1539,78 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 
 				},
 			},
+			{
+				Name:      "get-balances",
+				Usage:     "Get the node's ETH, RPL, and staked RPL balances, along with its withdrawal address balances",
+				UsageText: "rocketpool api node get-balances",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+
+					// Run
+					api.PrintResponse(getNodeBalances(c))
+					return nil
+
+				},
+			},
+			{
+				Name:      "get-metadata",
+				Usage:     "Get the node's timezone location, registration time, and fee distributor address",
+				UsageText: "rocketpool api node get-metadata",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+
+					// Run
+					api.PrintResponse(getNodeMetadata(c))
+					return nil
+
+				},
+			},
+			{
+				Name:      "get-doppelganger-risk",
+				Usage:     "Check whether any of the node's validators were live during the current or previous epoch, which means they're running elsewhere. The local validator client must be stopped.",
+				UsageText: "rocketpool api node get-doppelganger-risk",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+
+					// Run
+					api.PrintResponse(getDoppelgangerRisk(c))
+					return nil
+
+				},
+			},
+			{
+				Name:      "get-collateral-ratio-trend",
+				Usage:     "Get the node's borrowed collateral ratio now and at a previous slot. Requires an archive EC.",
+				UsageText: "rocketpool api node get-collateral-ratio-trend slot",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 1); err != nil {
+						return err
+					}
+					slot, err := cliutils.ValidateUint("slot", c.Args().Get(0))
+					if err != nil {
+						return err
+					}
+
+					// Run
+					api.PrintResponse(getCollateralRatioTrend(c, slot))
+					return nil
+
+				},
+			},
 
 			{
 				Name:      "can-send-message",
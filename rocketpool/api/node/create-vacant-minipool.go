@@ -17,6 +17,7 @@ import (
 	"github.com/rocket-pool/smartnode/shared/types/api"
 	cfgtypes "github.com/rocket-pool/smartnode/shared/types/config"
 	"github.com/rocket-pool/smartnode/shared/utils/eth1"
+	rputils "github.com/rocket-pool/smartnode/shared/utils/rp"
 	"github.com/urfave/cli"
 	"golang.org/x/sync/errgroup"
 )
@@ -80,6 +81,16 @@ func canCreateVacantMinipool(c *cli.Context, amountWei *big.Int, minNodeFee floa
 		return err
 	})
 
+	// Check the network's minipool limit
+	wg1.Go(func() error {
+		var err error
+		response.ActiveMinipoolCount, response.MinipoolLimit, err = rputils.GetMinipoolLimit(rp, nil)
+		if err == nil {
+			response.MinipoolLimitReached = (response.ActiveMinipoolCount >= response.MinipoolLimit)
+		}
+		return err
+	})
+
 	// Get node staking information
 	wg1.Go(func() error {
 		var err error
@@ -112,7 +123,7 @@ func canCreateVacantMinipool(c *cli.Context, amountWei *big.Int, minNodeFee floa
 	response.MinipoolAddress = minipoolAddress
 
 	// Update response
-	response.CanDeposit = !(response.InsufficientRplStake || response.InvalidAmount || response.DepositDisabled)
+	response.CanDeposit = !(response.InsufficientRplStake || response.InvalidAmount || response.DepositDisabled || response.MinipoolLimitReached)
 	if !response.CanDeposit {
 		return &response, nil
 	}
@@ -0,0 +1,185 @@
+package node
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/rocket-pool/rocketpool-go/network"
+	"github.com/rocket-pool/rocketpool-go/node"
+	"github.com/rocket-pool/rocketpool-go/settings/protocol"
+	"github.com/rocket-pool/rocketpool-go/tokens"
+	"github.com/urfave/cli"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/services/gas"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+	rputils "github.com/rocket-pool/smartnode/shared/utils/rp"
+)
+
+// Rough gas limits for the minipool creation transactions, used when one can't be simulated yet because the
+// transactions before it (approving and staking RPL) haven't been sent; transactions using them are flagged in the response
+const (
+	fallbackApproveRplGas uint64 = 60000
+	fallbackStakeRplGas   uint64 = 250000
+	fallbackDepositGas    uint64 = 2500000
+)
+
+// Estimate the ETH, RPL, and gas required for the node to create a minipool with the provided bond
+func getMinipoolCreationCost(c *cli.Context, bondAmountWei *big.Int) (*api.NodeMinipoolCreationCostResponse, error) {
+
+	// Get services
+	if err := services.RequireNodeRegistered(c); err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	ec, err := services.GetEthClient(c)
+	if err != nil {
+		return nil, err
+	}
+	rp, err := services.GetRocketPool(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check the bond amount
+	if !rputils.IsSupportedBondAmount(bondAmountWei) {
+		return nil, fmt.Errorf("Minipools can only be created with a bond of 8 or 16 ETH.")
+	}
+
+	// Response
+	response := api.NodeMinipoolCreationCostResponse{
+		BondAmount: bondAmountWei,
+	}
+
+	// Get node account
+	nodeAccount, err := w.GetNodeAccount()
+	if err != nil {
+		return nil, err
+	}
+
+	// Get the staking contract address
+	rocketNodeStakingAddress, err := rp.GetAddress("rocketNodeStaking", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// Data
+	var wg errgroup.Group
+	var minStakeFraction *big.Int
+	var nodeMinimumStake *big.Int
+	var nodeStake *big.Int
+	var allowance *big.Int
+	var recommendation gas.GasRecommendation
+
+	// Get data
+	wg.Go(func() error {
+		var err error
+		response.RplPrice, err = network.GetRPLPrice(rp, nil)
+		return err
+	})
+	wg.Go(func() error {
+		var err error
+		minStakeFraction, err = protocol.GetMinimumPerMinipoolStakeRaw(rp, nil)
+		return err
+	})
+	wg.Go(func() error {
+		var err error
+		nodeMinimumStake, err = node.GetNodeMinimumRPLStake(rp, nodeAccount.Address, nil)
+		return err
+	})
+	wg.Go(func() error {
+		var err error
+		nodeStake, err = node.GetNodeRPLStake(rp, nodeAccount.Address, nil)
+		return err
+	})
+	wg.Go(func() error {
+		var err error
+		allowance, err = tokens.GetRPLAllowance(rp, nodeAccount.Address, *rocketNodeStakingAddress, nil)
+		return err
+	})
+	wg.Go(func() error {
+		var err error
+		recommendation, err = gas.GetBlendedGasRecommendation(ec, false)
+		return err
+	})
+
+	// Wait for data
+	if err := wg.Wait(); err != nil {
+		return nil, err
+	}
+
+	if response.RplPrice.Sign() == 0 {
+		return nil, fmt.Errorf("The RPL price has not been set yet.")
+	}
+	response.MaxFeeWei = recommendation.MaxFeeWei
+
+	// The new minipool's minimum stake is a fraction of the ETH it borrows; only the part the node's current stake doesn't cover needs to be staked
+	response.MinimumRplStake = rputils.GetMinimumPerMinipoolRplStake(bondAmountWei, minStakeFraction, response.RplPrice)
+	response.RplToStake = big.NewInt(0).Add(nodeMinimumStake, response.MinimumRplStake)
+	response.RplToStake.Sub(response.RplToStake, nodeStake)
+	if response.RplToStake.Sign() < 0 {
+		response.RplToStake.SetUint64(0)
+	}
+
+	// Get the gas of the RPL transactions
+	opts, err := w.GetNodeAccountTransactor()
+	if err != nil {
+		return nil, err
+	}
+	if response.RplToStake.Sign() > 0 {
+		if allowance.Cmp(response.RplToStake) < 0 {
+			gasInfo, err := tokens.EstimateApproveRPLGas(rp, *rocketNodeStakingAddress, response.RplToStake, opts)
+			response.Transactions = append(response.Transactions, newMinipoolCreationTransaction("approve-rpl", gasInfo.EstGasLimit, fallbackApproveRplGas, err == nil))
+		}
+		gasInfo, err := node.EstimateStakeGas(rp, response.RplToStake, opts)
+		response.Transactions = append(response.Transactions, newMinipoolCreationTransaction("stake-rpl", gasInfo.EstGasLimit, fallbackStakeRplGas, err == nil))
+	}
+
+	// Get the gas of the deposit and how much of it the node's credit covers
+	canDeposit, err := canNodeDeposit(c, bondAmountWei, 0, big.NewInt(0))
+	if err != nil {
+		return nil, err
+	}
+	response.DepositFromWallet = big.NewInt(0).Set(bondAmountWei)
+	if canDeposit.CanUseCredit {
+		response.DepositFromWallet.Sub(response.DepositFromWallet, canDeposit.CreditBalance)
+		if response.DepositFromWallet.Sign() < 0 {
+			response.DepositFromWallet.SetUint64(0)
+		}
+	}
+	response.Transactions = append(response.Transactions, newMinipoolCreationTransaction("deposit", canDeposit.GasInfo.EstGasLimit, fallbackDepositGas, canDeposit.CanDeposit && canDeposit.GasInfo.EstGasLimit > 0))
+
+	// Sum the costs; if any transaction couldn't be simulated, the total only uses its rough gas limit
+	response.TotalGasCost = big.NewInt(0)
+	for i := range response.Transactions {
+		tx := &response.Transactions[i]
+		tx.MaxCost = big.NewInt(0).Mul(big.NewInt(0).SetUint64(tx.GasLimit), response.MaxFeeWei)
+		response.TotalGasCost.Add(response.TotalGasCost, tx.MaxCost)
+		if tx.GasLimitIsRough {
+			response.TotalGasCostIsRough = true
+		}
+	}
+	response.TotalEthCost = big.NewInt(0).Add(response.DepositFromWallet, response.TotalGasCost)
+
+	// Return response
+	return &response, nil
+
+}
+
+// Describe one of the minipool creation transactions, falling back to its rough gas limit if it couldn't be simulated
+func newMinipoolCreationTransaction(name string, estimatedGas uint64, fallbackGas uint64, simulated bool) api.MinipoolCreationTransaction {
+	tx := api.MinipoolCreationTransaction{
+		Name:      name,
+		GasLimit:  estimatedGas,
+		Simulated: simulated,
+	}
+	if !simulated {
+		tx.GasLimit = fallbackGas
+		tx.GasLimitIsRough = true
+	}
+	return tx
+}
@@ -118,6 +118,16 @@ func canNodeDeposit(c *cli.Context, amountWei *big.Int, minNodeFee float64, salt
 		return err
 	})
 
+	// Check the network's minipool limit
+	wg1.Go(func() error {
+		var err error
+		response.ActiveMinipoolCount, response.MinipoolLimit, err = rputils.GetMinipoolLimit(rp, nil)
+		if err == nil {
+			response.MinipoolLimitReached = (response.ActiveMinipoolCount >= response.MinipoolLimit)
+		}
+		return err
+	})
+
 	// Get node staking information
 	wg1.Go(func() error {
 		ethMatched, ethMatchedLimit, pendingMatchAmount, err = rputils.CheckCollateral(rp, nodeAccount.Address, nil)
@@ -155,7 +165,7 @@ func canNodeDeposit(c *cli.Context, amountWei *big.Int, minNodeFee float64, salt
 	response.InsufficientRplStake = (availableToMatch.Cmp(matchRequest) == -1)
 
 	// Update response
-	response.CanDeposit = !(response.InsufficientBalance || response.InsufficientRplStake || response.InvalidAmount || response.DepositDisabled)
+	response.CanDeposit = !(response.InsufficientBalance || response.InsufficientRplStake || response.InvalidAmount || response.DepositDisabled || response.MinipoolLimitReached)
 	if !response.CanDeposit {
 		return &response, nil
 	}
@@ -10,6 +10,7 @@ import (
 	"golang.org/x/sync/errgroup"
 
 	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/services/state"
 	"github.com/rocket-pool/smartnode/shared/types/api"
 	"github.com/rocket-pool/smartnode/shared/utils/eth1"
 )
@@ -139,10 +140,7 @@ func initializeFeeDistributor(c *cli.Context) (*api.NodeInitializeFeeDistributor
 
 func canDistribute(c *cli.Context) (*api.NodeCanDistributeResponse, error) {
 	// Get services
-	if err := services.RequireNodeWallet(c); err != nil {
-		return nil, err
-	}
-	if err := services.RequireRocketStorage(c); err != nil {
+	if err := services.RequireFeeDistributorInitialized(c); err != nil {
 		return nil, err
 	}
 	w, err := services.GetWallet(c)
@@ -215,12 +213,64 @@ func canDistribute(c *cli.Context) (*api.NodeCanDistributeResponse, error) {
 
 }
 
-func distribute(c *cli.Context) (*api.NodeDistributeResponse, error) {
+func getDistributorBalanceSplit(c *cli.Context) (*api.NodeDistributorBalanceSplitResponse, error) {
+
 	// Get services
-	if err := services.RequireNodeWallet(c); err != nil {
+	if err := services.RequireFeeDistributorInitialized(c); err != nil {
 		return nil, err
 	}
-	if err := services.RequireRocketStorage(c); err != nil {
+	if err := services.RequireBeaconClientSynced(c); err != nil {
+		return nil, err
+	}
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	rp, err := services.GetRocketPool(c)
+	if err != nil {
+		return nil, err
+	}
+	bc, err := services.GetBeaconClient(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.NodeDistributorBalanceSplitResponse{}
+
+	// Get node account
+	nodeAccount, err := w.GetNodeAccount()
+	if err != nil {
+		return nil, err
+	}
+
+	// Get the state, which splits the distributor balance using the node's average fee
+	m, err := state.NewNetworkStateManager(rp, cfg, rp.Client, bc, nil)
+	if err != nil {
+		return nil, err
+	}
+	networkState, _, err := m.GetHeadStateForNode(nodeAccount.Address, false)
+	if err != nil {
+		return nil, fmt.Errorf("error getting network state: %w", err)
+	}
+	nodeDetails := networkState.NodeDetailsByAddress[nodeAccount.Address]
+	response.Balance = nodeDetails.DistributorBalance
+	response.AverageNodeFee = eth.WeiToEth(nodeDetails.AverageNodeFee)
+	response.NodeShare = nodeDetails.DistributorBalanceNodeETH
+	response.ProtocolShare = nodeDetails.DistributorBalanceUserETH
+
+	// Return response
+	return &response, nil
+
+}
+
+func distribute(c *cli.Context) (*api.NodeDistributeResponse, error) {
+	// Get services
+	if err := services.RequireFeeDistributorInitialized(c); err != nil {
 		return nil, err
 	}
 	w, err := services.GetWallet(c)
@@ -0,0 +1,184 @@
+package node
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/services/beacon"
+	"github.com/rocket-pool/smartnode/shared/services/state"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+	"github.com/rocket-pool/smartnode/shared/utils/validator"
+)
+
+// Check whether any of the node's validators have been live on the Beacon chain during the current or previous epoch.
+// This is only a slashing risk if the local validator client isn't running, so the check refuses to run while it is, and
+// only checks the epochs that started after it stopped; any live validator is then being run somewhere else.
+func getDoppelgangerRisk(c *cli.Context) (*api.NodeDoppelgangerRiskResponse, error) {
+
+	// Get services
+	if err := services.RequireNodeRegistered(c); err != nil {
+		return nil, err
+	}
+	if err := services.RequireBeaconClientSynced(c); err != nil {
+		return nil, err
+	}
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	rp, err := services.GetRocketPool(c)
+	if err != nil {
+		return nil, err
+	}
+	bc, err := services.GetBeaconClient(c)
+	if err != nil {
+		return nil, err
+	}
+	d, err := services.GetDocker(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// The local validator client's own attestations would make every validator look live
+	running, err := validator.IsValidatorRunning(cfg, bc, d)
+	if err != nil {
+		return nil, fmt.Errorf("error checking the validator client status: %w", err)
+	}
+	if running {
+		return nil, fmt.Errorf("the validator client is running; stop it before checking for doppelgangers")
+	}
+	stopTime, err := validator.GetValidatorStopTime(cfg, bc, d)
+	if err != nil {
+		return nil, fmt.Errorf("error getting the time the validator client stopped: %w", err)
+	}
+
+	// Response
+	response := api.NodeDoppelgangerRiskResponse{
+		Epochs:           []uint64{},
+		ActiveValidators: []api.DoppelgangerValidator{},
+	}
+
+	// Get node account
+	nodeAccount, err := w.GetNodeAccount()
+	if err != nil {
+		return nil, err
+	}
+
+	// Get the state
+	m, err := state.NewNetworkStateManager(rp, cfg, rp.Client, bc, nil)
+	if err != nil {
+		return nil, err
+	}
+	networkState, _, err := m.GetHeadStateForNode(nodeAccount.Address, false)
+	if err != nil {
+		return nil, fmt.Errorf("error getting network state: %w", err)
+	}
+
+	// Get the node's validators that have an index on the Beacon chain
+	indices := []string{}
+	validators := map[string]api.DoppelgangerValidator{}
+	for _, mpd := range networkState.MinipoolDetailsByNode[nodeAccount.Address] {
+		validatorDetails, exists := networkState.ValidatorDetails[mpd.Pubkey]
+		if !exists || !validatorDetails.Exists || validatorDetails.Index == "" {
+			continue
+		}
+		indices = append(indices, validatorDetails.Index)
+		validators[validatorDetails.Index] = api.DoppelgangerValidator{
+			MinipoolAddress: mpd.MinipoolAddress,
+			Pubkey:          mpd.Pubkey,
+			Index:           validatorDetails.Index,
+			LiveEpochs:      []uint64{},
+		}
+	}
+
+	// Beacon clients only track liveness for the current and previous epochs, and the validator client's own duties can
+	// make its validators look live for the epoch it stopped in
+	firstEpoch := getFirstEpochAfter(stopTime, networkState.BeaconConfig)
+	response.Epochs = getDoppelgangerEpochs(networkState.BeaconSlotNumber, networkState.BeaconConfig.SlotsPerEpoch, firstEpoch)
+	if len(response.Epochs) == 0 {
+		return nil, fmt.Errorf("the validator client stopped recently; wait until epoch %d starts before checking for doppelgangers", firstEpoch)
+	}
+	response.Supported = true
+	if len(indices) == 0 {
+		return &response, nil
+	}
+
+	// Check each epoch
+	for _, epoch := range response.Epochs {
+		liveness, supported, err := bc.GetValidatorLiveness(indices, epoch)
+		if err != nil {
+			return nil, fmt.Errorf("error getting validator liveness for epoch %d: %w", epoch, err)
+		}
+		if !supported {
+			response.Supported = false
+			return &response, nil
+		}
+		recordLiveEpoch(validators, liveness, epoch)
+	}
+
+	// Flag every validator that was live
+	response.ActiveValidators = getLiveValidators(indices, validators)
+	response.AtRisk = len(response.ActiveValidators) > 0
+
+	// Return response
+	return &response, nil
+
+}
+
+// Get the epochs to check for liveness at the provided slot: the previous epoch (if there is one) and the current one,
+// skipping any before the first epoch
+func getDoppelgangerEpochs(slot uint64, slotsPerEpoch uint64, firstEpoch uint64) []uint64 {
+	epochs := []uint64{}
+	currentEpoch := slot / slotsPerEpoch
+	if currentEpoch > 0 && currentEpoch-1 >= firstEpoch {
+		epochs = append(epochs, currentEpoch-1)
+	}
+	if currentEpoch >= firstEpoch {
+		epochs = append(epochs, currentEpoch)
+	}
+	return epochs
+}
+
+// Get the first epoch that starts after the provided time
+func getFirstEpochAfter(t time.Time, beaconConfig beacon.Eth2Config) uint64 {
+	genesisTime := time.Unix(int64(beaconConfig.GenesisTime), 0)
+	if t.Before(genesisTime) {
+		return 0
+	}
+	slot := uint64(t.Sub(genesisTime).Seconds()) / beaconConfig.SecondsPerSlot
+	return slot/beaconConfig.SlotsPerEpoch + 1
+}
+
+// Record the epoch against each validator that was live during it
+func recordLiveEpoch(validators map[string]api.DoppelgangerValidator, liveness map[string]bool, epoch uint64) {
+	for index, isLive := range liveness {
+		if !isLive {
+			continue
+		}
+		validator, exists := validators[index]
+		if !exists {
+			continue
+		}
+		validator.LiveEpochs = append(validator.LiveEpochs, epoch)
+		validators[index] = validator
+	}
+}
+
+// Get the validators that were live during any of the checked epochs, in the order of the provided indices
+func getLiveValidators(indices []string, validators map[string]api.DoppelgangerValidator) []api.DoppelgangerValidator {
+	liveValidators := []api.DoppelgangerValidator{}
+	for _, index := range indices {
+		validator := validators[index]
+		if len(validator.LiveEpochs) > 0 {
+			liveValidators = append(liveValidators, validator)
+		}
+	}
+	return liveValidators
+}
@@ -0,0 +1,95 @@
+package node
+
+import (
+	"testing"
+	"time"
+
+	"github.com/rocket-pool/smartnode/shared/services/beacon"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+)
+
+func TestDoppelgangerEpochsIncludePreviousEpoch(t *testing.T) {
+	epochs := getDoppelgangerEpochs(3*32+5, 32, 0)
+	if len(epochs) != 2 || epochs[0] != 2 || epochs[1] != 3 {
+		t.Fatalf("expected epochs [2 3], got %v", epochs)
+	}
+
+	// The last slot of an epoch still belongs to it
+	epochs = getDoppelgangerEpochs(4*32-1, 32, 0)
+	if len(epochs) != 2 || epochs[0] != 2 || epochs[1] != 3 {
+		t.Fatalf("expected epochs [2 3] at the last slot of epoch 3, got %v", epochs)
+	}
+}
+
+func TestDoppelgangerEpochsAtGenesis(t *testing.T) {
+	epochs := getDoppelgangerEpochs(5, 32, 0)
+	if len(epochs) != 1 || epochs[0] != 0 {
+		t.Fatalf("expected epochs [0], got %v", epochs)
+	}
+}
+
+func TestDoppelgangerEpochsStartAfterTheValidatorClientStopped(t *testing.T) {
+	beaconConfig := beacon.Eth2Config{
+		GenesisTime:    1000,
+		SecondsPerSlot: 12,
+		SlotsPerEpoch:  32,
+	}
+
+	// Stopping during epoch 2 means its duties for epoch 2 may still show up
+	firstEpoch := getFirstEpochAfter(time.Unix(1000+(2*32+10)*12, 0), beaconConfig)
+	if firstEpoch != 3 {
+		t.Fatalf("expected the first epoch to be 3, got %d", firstEpoch)
+	}
+	epochs := getDoppelgangerEpochs(3*32+5, 32, firstEpoch)
+	if len(epochs) != 1 || epochs[0] != 3 {
+		t.Fatalf("expected epochs [3], got %v", epochs)
+	}
+
+	// Nothing can be checked until the next epoch starts
+	epochs = getDoppelgangerEpochs(2*32+20, 32, firstEpoch)
+	if len(epochs) != 0 {
+		t.Fatalf("expected no epochs, got %v", epochs)
+	}
+
+	// A container that never stopped doesn't rule out any epochs
+	if firstEpoch := getFirstEpochAfter(time.Time{}, beaconConfig); firstEpoch != 0 {
+		t.Fatalf("expected the first epoch to be 0, got %d", firstEpoch)
+	}
+}
+
+func TestOnlyLiveValidatorsAreFlagged(t *testing.T) {
+	indices := []string{"10", "11", "12"}
+	validators := map[string]api.DoppelgangerValidator{}
+	for _, index := range indices {
+		validators[index] = api.DoppelgangerValidator{Index: index, LiveEpochs: []uint64{}}
+	}
+
+	// 10 is live in both epochs, 12 only in the current one, 11 in neither; 99 isn't one of the node's validators
+	recordLiveEpoch(validators, map[string]bool{"10": true, "11": false, "12": false, "99": true}, 2)
+	recordLiveEpoch(validators, map[string]bool{"10": true, "11": false, "12": true, "99": true}, 3)
+
+	live := getLiveValidators(indices, validators)
+	if len(live) != 2 {
+		t.Fatalf("expected 2 live validators, got %d", len(live))
+	}
+	if live[0].Index != "10" || len(live[0].LiveEpochs) != 2 {
+		t.Fatalf("expected validator 10 to be live in 2 epochs, got %s in %v", live[0].Index, live[0].LiveEpochs)
+	}
+	if live[1].Index != "12" || len(live[1].LiveEpochs) != 1 || live[1].LiveEpochs[0] != 3 {
+		t.Fatalf("expected validator 12 to be live in epoch 3, got %s in %v", live[1].Index, live[1].LiveEpochs)
+	}
+	if _, exists := validators["99"]; exists {
+		t.Fatalf("liveness for an unknown validator should not be recorded")
+	}
+}
+
+func TestNoValidatorsFlaggedWhenNoneAreLive(t *testing.T) {
+	indices := []string{"10"}
+	validators := map[string]api.DoppelgangerValidator{
+		"10": {Index: "10", LiveEpochs: []uint64{}},
+	}
+	recordLiveEpoch(validators, map[string]bool{"10": false}, 3)
+	if live := getLiveValidators(indices, validators); len(live) != 0 {
+		t.Fatalf("expected no live validators, got %v", live)
+	}
+}
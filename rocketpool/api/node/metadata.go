@@ -0,0 +1,88 @@
+package node
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/rocket-pool/rocketpool-go/node"
+	"github.com/urfave/cli"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+)
+
+func getNodeMetadata(c *cli.Context) (*api.NodeMetadataResponse, error) {
+
+	// Get services
+	if err := services.RequireNodeRegistered(c); err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	rp, err := services.GetRocketPool(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.NodeMetadataResponse{}
+
+	// Get node account
+	nodeAccount, err := w.GetNodeAccount()
+	if err != nil {
+		return nil, err
+	}
+	response.AccountAddress = nodeAccount.Address
+
+	// Query everything at the same block so the metadata is consistent
+	blockNumber, err := rp.Client.BlockNumber(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("error getting the latest block number: %w", err)
+	}
+	opts := &bind.CallOpts{
+		BlockNumber: big.NewInt(0).SetUint64(blockNumber),
+	}
+
+	// Sync
+	var wg errgroup.Group
+
+	// Get data
+	wg.Go(func() error {
+		var err error
+		response.TimezoneLocation, err = node.GetNodeTimezoneLocation(rp, nodeAccount.Address, opts)
+		if err != nil {
+			return fmt.Errorf("error getting timezone location: %w", err)
+		}
+		return nil
+	})
+	wg.Go(func() error {
+		var err error
+		response.RegistrationTime, err = node.GetNodeRegistrationTime(rp, nodeAccount.Address, opts)
+		if err != nil {
+			return fmt.Errorf("error getting registration time: %w", err)
+		}
+		return nil
+	})
+	wg.Go(func() error {
+		var err error
+		response.FeeDistributorAddress, err = node.GetDistributorAddress(rp, nodeAccount.Address, opts)
+		if err != nil {
+			return fmt.Errorf("error getting fee distributor address: %w", err)
+		}
+		return nil
+	})
+
+	// Wait for data
+	if err := wg.Wait(); err != nil {
+		return nil, err
+	}
+
+	// Return response
+	return &response, nil
+
+}
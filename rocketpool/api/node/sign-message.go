@@ -12,15 +12,29 @@ import (
 	hexutils "github.com/rocket-pool/smartnode/shared/utils/hex"
 )
 
-func signMessage(c *cli.Context, message string) (*api.NodeSignResponse, error) {
+func signMessage(c *cli.Context, message string) (*api.NodeSignMessageResponse, error) {
+
+	// Get services
+	if err := services.RequireNodeWallet(c); err != nil {
+		return nil, err
+	}
 	w, err := services.GetWallet(c)
 	if err != nil {
 		return nil, err
 	}
 
 	// Response
-	response := api.NodeSignResponse{}
-	signedBytes, err := w.SignMessage(message)
+	response := api.NodeSignMessageResponse{}
+
+	// Get the signing address
+	nodeAccount, err := w.GetNodeAccount()
+	if err != nil {
+		return nil, err
+	}
+	response.SignerAddress = nodeAccount.Address
+
+	// Sign the message
+	signedBytes, err := w.SignMessage([]byte(message))
 	if err != nil {
 		return nil, fmt.Errorf("Error signing message [%s]: %w", message, err)
 	}
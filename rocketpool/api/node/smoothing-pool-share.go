@@ -0,0 +1,157 @@
+package node
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/fatih/color"
+	"github.com/rocket-pool/rocketpool-go/rewards"
+	rptypes "github.com/rocket-pool/rocketpool-go/types"
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/services"
+	rprewards "github.com/rocket-pool/smartnode/shared/services/rewards"
+	"github.com/rocket-pool/smartnode/shared/services/state"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+	"github.com/rocket-pool/smartnode/shared/utils/log"
+)
+
+func getSmoothingPoolShare(c *cli.Context) (*api.NodeSmoothingPoolShareResponse, error) {
+
+	// Get services
+	if err := services.RequireNodeRegistered(c); err != nil {
+		return nil, err
+	}
+	if err := services.RequireBeaconClientSynced(c); err != nil {
+		return nil, err
+	}
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	rp, err := services.GetRocketPool(c)
+	if err != nil {
+		return nil, err
+	}
+	bc, err := services.GetBeaconClient(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.NodeSmoothingPoolShareResponse{
+		EstimatedShare: big.NewInt(0),
+		NodeScore:      big.NewInt(0),
+	}
+
+	// Get node account
+	nodeAccount, err := w.GetNodeAccount()
+	if err != nil {
+		return nil, err
+	}
+
+	// Get the state
+	m, err := state.NewNetworkStateManager(rp, cfg, rp.Client, bc, nil)
+	if err != nil {
+		return nil, err
+	}
+	networkState, _, err := m.GetHeadStateForNode(nodeAccount.Address, false)
+	if err != nil {
+		return nil, fmt.Errorf("error getting network state: %w", err)
+	}
+	response.SmoothingPoolBalance = networkState.NetworkDetails.SmoothingPoolBalance
+	response.RewardsInterval = networkState.NetworkDetails.RewardIndex
+
+	// Nodes that weren't opted into the Smoothing Pool at any point in the interval don't get a share
+	participating, eligibleMinipools := getSmoothingPoolEligibility(networkState, nodeAccount.Address)
+	if !participating {
+		response.OptedOut = true
+		return &response, nil
+	}
+	response.EligibleMinipools = eligibleMinipools
+	if eligibleMinipools == 0 {
+		return &response, nil
+	}
+
+	// The scores come from the rolling record
+	if !cfg.Smartnode.UseRollingRecords.Value.(bool) {
+		return nil, fmt.Errorf("Estimating the node's Smoothing Pool share requires rolling records; please enable them in the Smartnode settings first.")
+	}
+	currentIndex := networkState.NetworkDetails.RewardIndex
+	if currentIndex == 0 {
+		return nil, fmt.Errorf("rolling records cannot be used for the first rewards interval")
+	}
+
+	// Get the start slot of the current interval
+	found, event, err := rewards.GetRewardsEvent(rp, currentIndex-1, cfg.Smartnode.GetPreviousRewardsPoolAddresses(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("error getting event for rewards interval %d: %w", currentIndex-1, err)
+	}
+	if !found {
+		return nil, fmt.Errorf("event for rewards interval %d not found", currentIndex-1)
+	}
+	beaconCfg, err := bc.GetEth2Config()
+	if err != nil {
+		return nil, fmt.Errorf("error getting Beacon config: %w", err)
+	}
+	startSlot, err := rprewards.GetStartSlotForInterval(event, bc, beaconCfg)
+	if err != nil {
+		return nil, fmt.Errorf("error getting start slot for interval %d: %w", currentIndex, err)
+	}
+
+	// Load the latest record checkpoint for the interval
+	logger := log.NewColorLogger(color.FgHiWhite)
+	recordMgr, err := rprewards.NewRollingRecordManager(&logger, &logger, cfg, rp, bc, m, startSlot, beaconCfg, currentIndex)
+	if err != nil {
+		return nil, fmt.Errorf("error creating rolling record manager: %w", err)
+	}
+	record, err := recordMgr.LoadBestRecordFromDisk(startSlot, networkState.BeaconSlotNumber, currentIndex)
+	if err != nil {
+		return nil, fmt.Errorf("error loading rolling record checkpoint from disk: %w", err)
+	}
+
+	// Estimate the node's share; this is only an estimate until the interval ends
+	response.IsEstimate = true
+	response.StartSlot = startSlot
+	response.RecordSlot = record.LastDutiesSlot
+	response.EstimatedShare, response.NodeScore, _ = record.GetNodeSmoothingPoolShare(nodeAccount.Address, response.SmoothingPoolBalance)
+
+	// Return response
+	return &response, nil
+
+}
+
+// Check whether the node was opted into the Smoothing Pool at any point during the current interval, and get the number
+// of its minipools that are eligible for it. This follows the rules of the rewards tree: a node that opted out after the
+// interval started still earns for the part it was opted in for, and a node with a minipool that has been penalized
+// 3 or more times isn't eligible at all.
+func getSmoothingPoolEligibility(networkState *state.NetworkState, nodeAddress common.Address) (bool, int) {
+	nodeDetails, exists := networkState.NodeDetailsByAddress[nodeAddress]
+	if !exists {
+		return false, 0
+	}
+	if !nodeDetails.SmoothingPoolRegistrationState {
+		optOutTime := time.Unix(nodeDetails.SmoothingPoolRegistrationChanged.Int64(), 0)
+		if !optOutTime.After(networkState.NetworkDetails.IntervalStart) {
+			return false, 0
+		}
+	}
+
+	eligibleMinipools := 0
+	for _, mpd := range networkState.MinipoolDetailsByNode[nodeAddress] {
+		if !mpd.Exists || mpd.Status != rptypes.Staking {
+			continue
+		}
+		if mpd.PenaltyCount.Uint64() >= 3 {
+			return true, 0
+		}
+		eligibleMinipools++
+	}
+	return true, eligibleMinipools
+}
@@ -0,0 +1,89 @@
+package node
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	rptypes "github.com/rocket-pool/rocketpool-go/types"
+	rpstate "github.com/rocket-pool/rocketpool-go/utils/state"
+
+	"github.com/rocket-pool/smartnode/shared/services/state"
+)
+
+var testIntervalStart = time.Unix(1700000000, 0)
+
+// Create a network state with one node that has the provided Smoothing Pool registration and minipools
+func newSmoothingPoolTestState(nodeAddress common.Address, optedIn bool, registrationChanged time.Time, minipools ...*rpstate.NativeMinipoolDetails) *state.NetworkState {
+	return &state.NetworkState{
+		NetworkDetails: &rpstate.NetworkDetails{
+			IntervalStart: testIntervalStart,
+		},
+		NodeDetailsByAddress: map[common.Address]*rpstate.NativeNodeDetails{
+			nodeAddress: {
+				NodeAddress:                      nodeAddress,
+				SmoothingPoolRegistrationState:   optedIn,
+				SmoothingPoolRegistrationChanged: big.NewInt(registrationChanged.Unix()),
+			},
+		},
+		MinipoolDetailsByNode: map[common.Address][]*rpstate.NativeMinipoolDetails{
+			nodeAddress: minipools,
+		},
+	}
+}
+
+func newSmoothingPoolTestMinipool(status rptypes.MinipoolStatus, penaltyCount uint64) *rpstate.NativeMinipoolDetails {
+	return &rpstate.NativeMinipoolDetails{
+		Exists:       true,
+		Status:       status,
+		PenaltyCount: big.NewInt(0).SetUint64(penaltyCount),
+	}
+}
+
+func TestSmoothingPoolEligibilityCountsStakingMinipools(t *testing.T) {
+	nodeAddress := common.HexToAddress("0x01")
+	networkState := newSmoothingPoolTestState(nodeAddress, true, testIntervalStart.Add(-time.Hour),
+		newSmoothingPoolTestMinipool(rptypes.Staking, 0),
+		newSmoothingPoolTestMinipool(rptypes.Staking, 2),
+		newSmoothingPoolTestMinipool(rptypes.Prelaunch, 0),
+	)
+	participating, eligible := getSmoothingPoolEligibility(networkState, nodeAddress)
+	if !participating || eligible != 2 {
+		t.Fatalf("expected a participating node with 2 eligible minipools, got %t and %d", participating, eligible)
+	}
+}
+
+func TestSmoothingPoolEligibilityIncludesNodesThatOptedOutDuringTheInterval(t *testing.T) {
+	nodeAddress := common.HexToAddress("0x01")
+	networkState := newSmoothingPoolTestState(nodeAddress, false, testIntervalStart.Add(time.Hour),
+		newSmoothingPoolTestMinipool(rptypes.Staking, 0),
+	)
+	participating, eligible := getSmoothingPoolEligibility(networkState, nodeAddress)
+	if !participating || eligible != 1 {
+		t.Fatalf("expected a node that opted out mid-interval to participate with 1 eligible minipool, got %t and %d", participating, eligible)
+	}
+}
+
+func TestSmoothingPoolEligibilityExcludesNodesThatOptedOutBeforeTheInterval(t *testing.T) {
+	nodeAddress := common.HexToAddress("0x01")
+	networkState := newSmoothingPoolTestState(nodeAddress, false, testIntervalStart.Add(-time.Hour),
+		newSmoothingPoolTestMinipool(rptypes.Staking, 0),
+	)
+	participating, eligible := getSmoothingPoolEligibility(networkState, nodeAddress)
+	if participating || eligible != 0 {
+		t.Fatalf("expected a node that opted out before the interval not to participate, got %t and %d", participating, eligible)
+	}
+}
+
+func TestSmoothingPoolEligibilityExcludesCheaters(t *testing.T) {
+	nodeAddress := common.HexToAddress("0x01")
+	networkState := newSmoothingPoolTestState(nodeAddress, true, testIntervalStart.Add(-time.Hour),
+		newSmoothingPoolTestMinipool(rptypes.Staking, 0),
+		newSmoothingPoolTestMinipool(rptypes.Staking, 3),
+	)
+	participating, eligible := getSmoothingPoolEligibility(networkState, nodeAddress)
+	if !participating || eligible != 0 {
+		t.Fatalf("expected a penalized node to have no eligible minipools, got %t and %d", participating, eligible)
+	}
+}
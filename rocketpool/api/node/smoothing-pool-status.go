@@ -0,0 +1,75 @@
+package node
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/services/state"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+)
+
+func getSmoothingPoolStatus(c *cli.Context) (*api.NodeSmoothingPoolStatusResponse, error) {
+
+	// Get services
+	if err := services.RequireNodeRegistered(c); err != nil {
+		return nil, err
+	}
+	if err := services.RequireBeaconClientSynced(c); err != nil {
+		return nil, err
+	}
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	rp, err := services.GetRocketPool(c)
+	if err != nil {
+		return nil, err
+	}
+	bc, err := services.GetBeaconClient(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.NodeSmoothingPoolStatusResponse{
+		SmoothingPoolBalance: big.NewInt(0),
+	}
+
+	// Get node account
+	nodeAccount, err := w.GetNodeAccount()
+	if err != nil {
+		return nil, err
+	}
+
+	// Get the state
+	m, err := state.NewNetworkStateManager(rp, cfg, rp.Client, bc, nil)
+	if err != nil {
+		return nil, err
+	}
+	networkState, _, err := m.GetHeadStateForNode(nodeAccount.Address, false)
+	if err != nil {
+		return nil, fmt.Errorf("error getting network state: %w", err)
+	}
+	response.SmoothingPoolBalance = networkState.NetworkDetails.SmoothingPoolBalance
+	response.RewardsInterval = networkState.NetworkDetails.RewardIndex
+	response.IntervalStart = networkState.NetworkDetails.IntervalStart
+
+	// Nodes that weren't opted into the Smoothing Pool at any point in the interval don't have any eligible minipools
+	participating, eligibleMinipools := getSmoothingPoolEligibility(networkState, nodeAccount.Address)
+	if !participating {
+		response.OptedOut = true
+		return &response, nil
+	}
+	response.EligibleMinipools = eligibleMinipools
+
+	// Return response
+	return &response, nil
+
+}
@@ -79,6 +79,63 @@ func getSmoothingPoolRegistrationStatus(c *cli.Context) (*api.GetSmoothingPoolRe
 
 }
 
+func getSmoothingPoolRegistrationChangeTime(c *cli.Context) (*api.SmoothingPoolRegistrationChangeTimeResponse, error) {
+
+	// Get services
+	if err := services.RequireNodeWallet(c); err != nil {
+		return nil, err
+	}
+	if err := services.RequireRocketStorage(c); err != nil {
+		return nil, err
+	}
+	rp, err := services.GetRocketPool(c)
+	if err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	ec, err := services.GetEthClient(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.SmoothingPoolRegistrationChangeTimeResponse{}
+
+	// Get node account
+	nodeAccount, err := w.GetNodeAccount()
+	if err != nil {
+		return nil, err
+	}
+
+	// Get the time of the last change
+	response.LastChangeTime, err = node.GetSmoothingPoolRegistrationChanged(rp, nodeAccount.Address, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// The status can only be changed once per rewards interval
+	intervalTime, err := rewards.GetClaimIntervalTime(rp, nil)
+	if err != nil {
+		return nil, err
+	}
+	response.NextChangeTime = response.LastChangeTime.Add(intervalTime)
+
+	// Check if the lock has elapsed as of the latest block
+	latestBlockTimeUnix, err := services.GetEthClientLatestBlockTimestamp(ec)
+	if err != nil {
+		return nil, err
+	}
+	latestBlockTime := time.Unix(int64(latestBlockTimeUnix), 0)
+	response.CanChangeNow = !latestBlockTime.Before(response.NextChangeTime)
+
+	// Return response
+	return &response, nil
+
+}
+
 func canSetSmoothingPoolStatus(c *cli.Context, status bool) (*api.CanSetSmoothingPoolRegistrationStatusResponse, error) {
 
 	// Get services
@@ -0,0 +1,84 @@
+package node
+
+import (
+	"math/big"
+
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/services"
+	rprewards "github.com/rocket-pool/smartnode/shared/services/rewards"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+)
+
+func getUnclaimedRewardsIntervals(c *cli.Context) (*api.NodeUnclaimedRewardsIntervalsResponse, error) {
+
+	// Get services
+	if err := services.RequireNodeRegistered(c); err != nil {
+		return nil, err
+	}
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	rp, err := services.GetRocketPool(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.NodeUnclaimedRewardsIntervalsResponse{
+		Intervals:        []api.UnclaimedRewardsInterval{},
+		InvalidIntervals: []uint64{},
+		TotalRpl:         big.NewInt(0),
+		TotalEth:         big.NewInt(0),
+	}
+
+	// Get node account
+	nodeAccount, err := w.GetNodeAccount()
+	if err != nil {
+		return nil, err
+	}
+
+	// Get the unclaimed intervals
+	unclaimed, _, err := rprewards.GetClaimStatus(rp, nodeAccount.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get the amounts owed for each one
+	for _, unclaimedInterval := range unclaimed {
+		intervalInfo, err := rprewards.GetIntervalInfo(rp, cfg, nodeAccount.Address, unclaimedInterval, nil)
+		if err != nil {
+			return nil, err
+		}
+		if !intervalInfo.TreeFileExists || !intervalInfo.MerkleRootValid {
+			// The amounts can't be trusted without a valid tree file
+			response.InvalidIntervals = append(response.InvalidIntervals, unclaimedInterval)
+			continue
+		}
+		if !intervalInfo.NodeExists {
+			// The node didn't earn anything during this interval
+			continue
+		}
+
+		rplAmount := big.NewInt(0).Add(&intervalInfo.CollateralRplAmount.Int, &intervalInfo.ODaoRplAmount.Int)
+		ethAmount := big.NewInt(0).Set(&intervalInfo.SmoothingPoolEthAmount.Int)
+		response.Intervals = append(response.Intervals, api.UnclaimedRewardsInterval{
+			Index:     intervalInfo.Index,
+			StartTime: intervalInfo.StartTime,
+			EndTime:   intervalInfo.EndTime,
+			RplAmount: rplAmount,
+			EthAmount: ethAmount,
+		})
+		response.TotalRpl.Add(response.TotalRpl, rplAmount)
+		response.TotalEth.Add(response.TotalEth, ethAmount)
+	}
+
+	// Return response
+	return &response, nil
+
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/rocket-pool/rocketpool-go/storage"
 	"github.com/urfave/cli"
+	"golang.org/x/sync/errgroup"
 
 	"github.com/rocket-pool/smartnode/shared/services"
 	"github.com/rocket-pool/smartnode/shared/types/api"
@@ -289,3 +290,58 @@ func getNodePendingWithdrawalAddress(c *cli.Context) (*api.GetNodePendingWithdra
 	return &response, nil
 
 }
+
+func getWithdrawalAddressStatus(c *cli.Context) (*api.NodeWithdrawalAddressStatusResponse, error) {
+
+	// Get services
+	if err := services.RequireNodeRegistered(c); err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	rp, err := services.GetRocketPool(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.NodeWithdrawalAddressStatusResponse{}
+
+	// Get the node's account
+	nodeAccount, err := w.GetNodeAccount()
+	if err != nil {
+		return nil, err
+	}
+
+	// Sync
+	var wg errgroup.Group
+
+	// Get the current address
+	wg.Go(func() error {
+		var err error
+		response.Address, err = storage.GetNodeWithdrawalAddress(rp, nodeAccount.Address, nil)
+		return err
+	})
+
+	// Get the pending address; this is the zero address if there's no change in progress
+	wg.Go(func() error {
+		var err error
+		response.PendingAddress, err = storage.GetNodePendingWithdrawalAddress(rp, nodeAccount.Address, nil)
+		return err
+	})
+
+	// Wait for data
+	if err := wg.Wait(); err != nil {
+		return nil, err
+	}
+
+	// A pending change only takes effect once the pending address confirms it
+	response.ConfirmationRequired = (response.PendingAddress != common.Address{})
+	response.NodeCanConfirm = (response.PendingAddress == nodeAccount.Address)
+
+	// Return response
+	return &response, nil
+
+}
@@ -52,6 +52,84 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 
 				},
 			},
+			{
+				Name:      "member-details",
+				Usage:     "Get the details of every oracle DAO member in a single batch",
+				UsageText: "rocketpool api odao member-details",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+
+					// Run
+					api.PrintResponse(getMemberDetails(c))
+					return nil
+
+				},
+			},
+
+			{
+				Name:      "get-scrub-queue",
+				Usage:     "Get the prelaunch minipools that are still in their scrub window, with their deposit data for review",
+				UsageText: "rocketpool api odao get-scrub-queue",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+
+					// Run
+					api.PrintResponse(getScrubQueue(c))
+					return nil
+
+				},
+			},
+
+			{
+				Name:      "can-vote-scrub",
+				Usage:     "Check whether the node can vote to scrub a set of minipools, and why not for those it can't",
+				UsageText: "rocketpool api odao can-vote-scrub minipool-addresses",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 1); err != nil {
+						return err
+					}
+					minipoolAddresses, err := cliutils.ValidateAddresses("minipool address", c.Args().Get(0))
+					if err != nil {
+						return err
+					}
+
+					// Run
+					api.PrintResponse(canVoteScrub(c, minipoolAddresses))
+					return nil
+
+				},
+			},
+			{
+				Name:      "vote-scrub",
+				Usage:     "Vote to scrub a set of minipools, skipping those the node can't vote on",
+				UsageText: "rocketpool api odao vote-scrub minipool-addresses",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 1); err != nil {
+						return err
+					}
+					minipoolAddresses, err := cliutils.ValidateAddresses("minipool address", c.Args().Get(0))
+					if err != nil {
+						return err
+					}
+
+					// Run
+					api.PrintResponse(voteScrub(c, minipoolAddresses))
+					return nil
+
+				},
+			},
 
 			{
 				Name:      "proposals",
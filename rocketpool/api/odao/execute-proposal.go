@@ -2,6 +2,7 @@ package odao
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/rocket-pool/rocketpool-go/dao"
 	"github.com/rocket-pool/rocketpool-go/dao/trustednode"
@@ -51,20 +52,17 @@ func canExecuteProposal(c *cli.Context, proposalId uint64) (*api.CanExecuteTNDAO
 	wg.Go(func() error {
 		proposalState, err := dao.GetProposalState(rp, proposalId, nil)
 		if err == nil {
-			response.InvalidState = (proposalState != rptypes.Succeeded)
+			response.TooEarly = (proposalState == rptypes.Pending)
+			response.InvalidState = (proposalState != rptypes.Succeeded && proposalState != rptypes.Pending)
 		}
 		return err
 	})
 
-	// Get gas estimate
+	// Get the earliest time the proposal can be executed
 	wg.Go(func() error {
-		opts, err := w.GetNodeAccountTransactor()
-		if err != nil {
-			return err
-		}
-		gasInfo, err := trustednode.EstimateExecuteProposalGas(rp, proposalId, opts)
+		startTime, err := dao.GetProposalStartTime(rp, proposalId, nil)
 		if err == nil {
-			response.GasInfo = gasInfo
+			response.ExecutableTime = time.Unix(int64(startTime), 0)
 		}
 		return err
 	})
@@ -74,8 +72,23 @@ func canExecuteProposal(c *cli.Context, proposalId uint64) (*api.CanExecuteTNDAO
 		return nil, err
 	}
 
-	// Update & return response
-	response.CanExecute = !(response.DoesNotExist || response.InvalidState)
+	// Update response
+	response.CanExecute = !(response.DoesNotExist || response.TooEarly || response.InvalidState)
+
+	// Get gas estimate, which would revert if the proposal can't be executed yet
+	if response.CanExecute {
+		opts, err := w.GetNodeAccountTransactor()
+		if err != nil {
+			return nil, err
+		}
+		gasInfo, err := trustednode.EstimateExecuteProposalGas(rp, proposalId, opts)
+		if err != nil {
+			return nil, err
+		}
+		response.GasInfo = gasInfo
+	}
+
+	// Return response
 	return &response, nil
 
 }
@@ -113,7 +126,23 @@ func executeProposal(c *cli.Context, proposalId uint64) (*api.ExecuteTNDAOPropos
 		return nil, fmt.Errorf("Error checking for nonce override: %w", err)
 	}
 
-	// Cancel proposal
+	// Make sure the proposal has passed and its voting period has started
+	proposalState, err := dao.GetProposalState(rp, proposalId, nil)
+	if err != nil {
+		return nil, err
+	}
+	if proposalState == rptypes.Pending {
+		startTime, err := dao.GetProposalStartTime(rp, proposalId, nil)
+		if err != nil {
+			return nil, err
+		}
+		return nil, fmt.Errorf("Proposal %d cannot be executed until its voting period starts at %s.", proposalId, time.Unix(int64(startTime), 0).Format(time.RFC822))
+	}
+	if proposalState != rptypes.Succeeded {
+		return nil, fmt.Errorf("Proposal %d cannot be executed because its state is %s.", proposalId, proposalState.String())
+	}
+
+	// Execute proposal
 	hash, err := trustednode.ExecuteProposal(rp, proposalId, opts)
 	if err != nil {
 		return nil, err
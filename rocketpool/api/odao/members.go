@@ -1,7 +1,11 @@
 package odao
 
 import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/rocket-pool/rocketpool-go/dao/trustednode"
+	rpstate "github.com/rocket-pool/rocketpool-go/utils/state"
 	"github.com/urfave/cli"
 
 	"github.com/rocket-pool/smartnode/shared/services"
@@ -33,3 +37,49 @@ func getMembers(c *cli.Context) (*api.TNDAOMembersResponse, error) {
 	return &response, nil
 
 }
+
+func getMemberDetails(c *cli.Context) (*api.TNDAOMemberDetailsResponse, error) {
+
+	// Get services
+	if err := services.RequireRocketStorage(c); err != nil {
+		return nil, err
+	}
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	rp, err := services.GetRocketPool(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.TNDAOMemberDetailsResponse{
+		Members: []api.TNDAOMemberDetails{},
+	}
+
+	// Get the details of every member in a single batch
+	multicallerAddress := common.HexToAddress(cfg.Smartnode.GetMulticallAddress())
+	balanceBatcherAddress := common.HexToAddress(cfg.Smartnode.GetBalanceBatcherAddress())
+	contracts, err := rpstate.NewNetworkContracts(rp, multicallerAddress, balanceBatcherAddress, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error getting network contracts: %w", err)
+	}
+	members, err := rpstate.GetAllOracleDaoMemberDetails(rp, contracts)
+	if err != nil {
+		return nil, fmt.Errorf("error getting oracle DAO member details: %w", err)
+	}
+	for _, member := range members {
+		response.Members = append(response.Members, api.TNDAOMemberDetails{
+			Address:       member.Address,
+			ID:            member.ID,
+			Url:           member.Url,
+			JoinedTime:    member.JoinedTime,
+			RPLBondAmount: member.RPLBondAmount,
+		})
+	}
+
+	// Return response
+	return &response, nil
+
+}
@@ -0,0 +1,124 @@
+package odao
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	rptypes "github.com/rocket-pool/rocketpool-go/types"
+	rputils "github.com/rocket-pool/rocketpool-go/utils"
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/services/state"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+)
+
+// Get the prelaunch minipools that are still in their scrub window, along with their deposit data
+func getScrubQueue(c *cli.Context) (*api.TNDAOScrubQueueResponse, error) {
+
+	// Get services
+	if err := services.RequireNodeTrusted(c); err != nil {
+		return nil, err
+	}
+	if err := services.RequireBeaconClientSynced(c); err != nil {
+		return nil, err
+	}
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	rp, err := services.GetRocketPool(c)
+	if err != nil {
+		return nil, err
+	}
+	bc, err := services.GetBeaconClient(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.TNDAOScrubQueueResponse{
+		Minipools: []api.ScrubQueueMinipool{},
+	}
+
+	// Get the state
+	m, err := state.NewNetworkStateManager(rp, cfg, rp.Client, bc, nil)
+	if err != nil {
+		return nil, err
+	}
+	networkState, err := m.GetHeadState()
+	if err != nil {
+		return nil, fmt.Errorf("error getting network state: %w", err)
+	}
+	genesisTime := time.Unix(int64(networkState.BeaconConfig.GenesisTime), 0)
+	stateTime := genesisTime.Add(time.Duration(networkState.BeaconSlotNumber*networkState.BeaconConfig.SecondsPerSlot) * time.Second)
+	response.ScrubPeriod = networkState.NetworkDetails.ScrubPeriod
+	response.PromotionScrubPeriod = networkState.NetworkDetails.PromotionScrubPeriod
+
+	// Find the prelaunch minipools whose scrub window hasn't ended; scrubbed minipools are dissolved, so they're no longer in prelaunch
+	pubkeys := map[rptypes.ValidatorPubkey]bool{}
+	oldestPrelaunchTime := stateTime
+	for _, mpd := range networkState.MinipoolDetails {
+		if mpd.Status != rptypes.Prelaunch {
+			continue
+		}
+		scrubPeriod := networkState.NetworkDetails.ScrubPeriod
+		if mpd.IsVacant {
+			scrubPeriod = networkState.NetworkDetails.PromotionScrubPeriod
+		}
+		prelaunchTime := time.Unix(mpd.StatusTime.Int64(), 0)
+		scrubWindowEnd := prelaunchTime.Add(scrubPeriod)
+		if !stateTime.Before(scrubWindowEnd) {
+			continue
+		}
+
+		response.Minipools = append(response.Minipools, api.ScrubQueueMinipool{
+			Address:               mpd.MinipoolAddress,
+			NodeAddress:           mpd.NodeAddress,
+			Pubkey:                mpd.Pubkey,
+			WithdrawalCredentials: mpd.WithdrawalCredentials,
+			IsVacant:              mpd.IsVacant,
+			PrelaunchTime:         prelaunchTime,
+			ScrubWindowEnd:        scrubWindowEnd,
+			TimeLeft:              scrubWindowEnd.Sub(stateTime),
+			Deposits:              []rputils.DepositData{},
+		})
+
+		// Vacant minipools are existing validators, so their deposits aren't from their time in prelaunch
+		if !mpd.IsVacant {
+			pubkeys[mpd.Pubkey] = true
+			if prelaunchTime.Before(oldestPrelaunchTime) {
+				oldestPrelaunchTime = prelaunchTime
+			}
+		}
+	}
+	if len(pubkeys) == 0 {
+		return &response, nil
+	}
+
+	// Get the deposits made since the oldest minipool entered prelaunch; there's at most one block per slot, so this goes back far enough
+	eventLogInterval, err := cfg.GetEventLogInterval()
+	if err != nil {
+		return nil, fmt.Errorf("error getting event log interval: %w", err)
+	}
+	slotsSincePrelaunch := uint64(stateTime.Sub(oldestPrelaunchTime).Seconds())/networkState.BeaconConfig.SecondsPerSlot + 1
+	startBlock := uint64(0)
+	if networkState.ElBlockNumber > slotsSincePrelaunch {
+		startBlock = networkState.ElBlockNumber - slotsSincePrelaunch
+	}
+	depositMap, err := rputils.GetDeposits(rp, pubkeys, big.NewInt(0).SetUint64(startBlock), big.NewInt(int64(eventLogInterval)), nil)
+	if err != nil {
+		return nil, fmt.Errorf("error getting deposits: %w", err)
+	}
+	for i := range response.Minipools {
+		minipool := &response.Minipools[i]
+		if deposits, exists := depositMap[minipool.Pubkey]; exists {
+			minipool.Deposits = deposits
+		}
+	}
+
+	// Return response
+	return &response, nil
+
+}
@@ -0,0 +1,249 @@
+package odao
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rocket-pool/rocketpool-go/minipool"
+	"github.com/rocket-pool/rocketpool-go/rocketpool"
+	tnsettings "github.com/rocket-pool/rocketpool-go/settings/trustednode"
+	rptypes "github.com/rocket-pool/rocketpool-go/types"
+	"github.com/rocket-pool/rocketpool-go/utils/eth"
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/services/config"
+	"github.com/rocket-pool/smartnode/shared/services/wallet"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+	"github.com/rocket-pool/smartnode/shared/utils/eth1"
+)
+
+func canVoteScrub(c *cli.Context, minipoolAddresses []common.Address) (*api.CanTNDAOVoteScrubResponse, error) {
+
+	// Get services
+	if err := services.RequireNodeTrusted(c); err != nil {
+		return nil, err
+	}
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	rp, err := services.GetRocketPool(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.CanTNDAOVoteScrubResponse{}
+
+	// Check each minipool
+	response.Details, err = getBatchVoteScrubDetails(rp, cfg, w, minipoolAddresses)
+	if err != nil {
+		return nil, err
+	}
+
+	// Sum the gas of the minipools that can be voted on
+	for _, minipoolDetails := range response.Details {
+		if !minipoolDetails.CanVote {
+			continue
+		}
+		response.CanVote = true
+		response.GasInfo.EstGasLimit += minipoolDetails.GasInfo.EstGasLimit
+		response.GasInfo.SafeGasLimit += minipoolDetails.GasInfo.SafeGasLimit
+	}
+
+	// Return response
+	return &response, nil
+
+}
+
+func voteScrub(c *cli.Context, minipoolAddresses []common.Address) (*api.TNDAOVoteScrubResponse, error) {
+
+	// Get services
+	if err := services.RequireNodeTrusted(c); err != nil {
+		return nil, err
+	}
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	rp, err := services.GetRocketPool(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.TNDAOVoteScrubResponse{}
+
+	// Check each minipool again, since scrub windows may have ended or other votes been cast since the check
+	response.Details, err = getBatchVoteScrubDetails(rp, cfg, w, minipoolAddresses)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get transactor
+	opts, err := w.GetNodeAccountTransactor()
+	if err != nil {
+		return nil, err
+	}
+
+	// Override the provided pending TX if requested; the following transactions use the nonces after it
+	err = eth1.CheckForNonceOverride(c, opts)
+	if err != nil {
+		return nil, fmt.Errorf("Error checking for nonce override: %w", err)
+	}
+
+	// Vote to scrub each minipool; a failure on one minipool is recorded against it so the hashes of the
+	// transactions that were already sent are still returned
+	for i := range response.Details {
+		minipoolDetails := &response.Details[i]
+		if !minipoolDetails.CanVote {
+			continue
+		}
+		hash, err := voteScrubMinipool(rp, minipoolDetails, opts)
+		if err != nil {
+			minipoolDetails.Error = err.Error()
+			continue
+		}
+		minipoolDetails.TxHash = hash
+		response.TxHashes = append(response.TxHashes, hash)
+		if opts.Nonce != nil {
+			opts.Nonce = big.NewInt(0).Add(opts.Nonce, big.NewInt(1))
+		}
+	}
+
+	// Return response
+	return &response, nil
+
+}
+
+// Send the scrub vote transaction for a single minipool
+func voteScrubMinipool(rp *rocketpool.RocketPool, minipoolDetails *api.TNDAOVoteScrubDetails, opts *bind.TransactOpts) (common.Hash, error) {
+	mp, err := minipool.NewMinipool(rp, minipoolDetails.Address, nil)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	opts.GasLimit = minipoolDetails.GasInfo.SafeGasLimit
+	hash, err := mp.VoteScrub(opts)
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("error voting to scrub minipool %s: %w", minipoolDetails.Address.Hex(), err)
+	}
+	return hash, nil
+}
+
+// Check whether the node can vote to scrub each of the provided minipools, and why not if it can't
+func getBatchVoteScrubDetails(rp *rocketpool.RocketPool, cfg *config.RocketPoolConfig, w *wallet.Wallet, minipoolAddresses []common.Address) ([]api.TNDAOVoteScrubDetails, error) {
+
+	// Get node account
+	nodeAccount, err := w.GetNodeAccount()
+	if err != nil {
+		return nil, err
+	}
+
+	// Get the scrub periods and the latest block time
+	scrubPeriod, err := tnsettings.GetScrubPeriod(rp, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error getting scrub period: %w", err)
+	}
+	promotionScrubPeriod, err := tnsettings.GetPromotionScrubPeriod(rp, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error getting promotion scrub period: %w", err)
+	}
+	latestHeader, err := rp.Client.HeaderByNumber(context.Background(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("error getting latest block header: %w", err)
+	}
+	latestBlockTime := time.Unix(int64(latestHeader.Time), 0)
+	eventLogInterval, err := cfg.GetEventLogInterval()
+	if err != nil {
+		return nil, fmt.Errorf("error getting event log interval: %w", err)
+	}
+
+	// Get transactor for the gas estimates
+	opts, err := w.GetNodeAccountTransactor()
+	if err != nil {
+		return nil, err
+	}
+
+	// Check each minipool
+	details := make([]api.TNDAOVoteScrubDetails, len(minipoolAddresses))
+	for i, address := range minipoolAddresses {
+		minipoolDetails := &details[i]
+		minipoolDetails.Address = address
+
+		mp, err := minipool.NewMinipool(rp, address, nil)
+		if err != nil {
+			return nil, fmt.Errorf("error creating binding for minipool %s: %w", address.Hex(), err)
+		}
+		statusDetails, err := mp.GetStatusDetails(nil)
+		if err != nil {
+			return nil, fmt.Errorf("error getting status of minipool %s: %w", address.Hex(), err)
+		}
+		minipoolDetails.IsVacant = statusDetails.IsVacant
+		if statusDetails.Status != rptypes.Prelaunch {
+			minipoolDetails.SkipReason = fmt.Sprintf("the minipool is in %s status, not prelaunch", statusDetails.Status.String())
+			continue
+		}
+
+		// Vacant minipools use the promotion scrub period instead of the regular one
+		windowLength := time.Duration(scrubPeriod) * time.Second
+		if statusDetails.IsVacant {
+			windowLength = time.Duration(promotionScrubPeriod) * time.Second
+		}
+		minipoolDetails.ScrubWindowEnd = statusDetails.StatusTime.Add(windowLength)
+		if !latestBlockTime.Before(minipoolDetails.ScrubWindowEnd) {
+			minipoolDetails.SkipReason = "the minipool's scrub window has ended"
+			continue
+		}
+
+		// The minipool doesn't expose its scrub votes, so look for one of the node's since it entered prelaunch
+		hasVoted, err := hasVotedToScrub(rp, mp, nodeAccount.Address, statusDetails.StatusBlock, big.NewInt(int64(eventLogInterval)))
+		if err != nil {
+			return nil, err
+		}
+		if hasVoted {
+			minipoolDetails.SkipReason = "the node has already voted to scrub the minipool"
+			continue
+		}
+
+		// The vote is locked if the transaction would revert
+		gasInfo, err := mp.EstimateVoteScrubGas(opts)
+		if err != nil {
+			minipoolDetails.SkipReason = fmt.Sprintf("the scrub vote cannot be performed: %s", err.Error())
+			continue
+		}
+		minipoolDetails.CanVote = true
+		minipoolDetails.GasInfo = gasInfo
+	}
+
+	return details, nil
+
+}
+
+// Check whether a member has emitted a scrub vote event on a minipool since the provided block.
+// If the minipool's ABI doesn't have the event, this reports no vote and leaves duplicates to the gas estimate.
+func hasVotedToScrub(rp *rocketpool.RocketPool, mp minipool.Minipool, memberAddress common.Address, fromBlock uint64, intervalSize *big.Int) (bool, error) {
+	scrubVotedEvent, exists := mp.GetContract().ABI.Events["ScrubVoted"]
+	if !exists {
+		return false, nil
+	}
+	addressFilter := []common.Address{mp.GetAddress()}
+	topicFilter := [][]common.Hash{{scrubVotedEvent.ID}, {common.BytesToHash(memberAddress.Bytes())}}
+	logs, err := eth.GetLogs(rp, addressFilter, topicFilter, intervalSize, big.NewInt(0).SetUint64(fromBlock), nil, nil)
+	if err != nil {
+		return false, fmt.Errorf("error getting scrub votes of minipool %s: %w", mp.GetAddress().Hex(), err)
+	}
+	return len(logs) > 0, nil
+}
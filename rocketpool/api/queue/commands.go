@@ -34,6 +34,42 @@ func RegisterSubcommands(command *cli.Command, name string, aliases []string) {
 				},
 			},
 
+			{
+				Name:      "deposit-pool-status",
+				Usage:     "Get the deposit pool's balance, capacity, and whether deposits are enabled",
+				UsageText: "rocketpool api queue deposit-pool-status",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+
+					// Run
+					api.PrintResponse(getDepositPoolStatus(c))
+					return nil
+
+				},
+			},
+
+			{
+				Name:      "positions",
+				Usage:     "Get the queue position of each of the node's minipools and an estimate of when they will be assigned",
+				UsageText: "rocketpool api queue positions",
+				Action: func(c *cli.Context) error {
+
+					// Validate args
+					if err := cliutils.ValidateArgCount(c, 0); err != nil {
+						return err
+					}
+
+					// Run
+					api.PrintResponse(getQueuePositions(c))
+					return nil
+
+				},
+			},
+
 			{
 				Name:      "can-process",
 				Usage:     "Check whether the deposit pool can be processed",
@@ -0,0 +1,43 @@
+package queue
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+	rputils "github.com/rocket-pool/smartnode/shared/utils/rp"
+)
+
+func getDepositPoolStatus(c *cli.Context) (*api.DepositPoolStatusResponse, error) {
+
+	// Get services
+	if err := services.RequireRocketStorage(c); err != nil {
+		return nil, err
+	}
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	rp, err := services.GetRocketPool(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.DepositPoolStatusResponse{}
+
+	// Get the deposit pool status
+	status, err := rputils.GetDepositPoolStatus(rp, common.HexToAddress(cfg.Smartnode.GetMulticallAddress()), nil)
+	if err != nil {
+		return nil, err
+	}
+	response.Balance = status.Balance
+	response.ExcessBalance = status.ExcessBalance
+	response.Capacity = status.Capacity
+	response.DepositsEnabled = status.DepositsEnabled
+
+	// Return response
+	return &response, nil
+
+}
@@ -0,0 +1,198 @@
+package queue
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rocket-pool/rocketpool-go/deposit"
+	"github.com/rocket-pool/rocketpool-go/minipool"
+	"github.com/rocket-pool/rocketpool-go/rocketpool"
+	"github.com/rocket-pool/rocketpool-go/utils/eth"
+	"github.com/urfave/cli"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/rocket-pool/smartnode/shared/services"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+)
+
+// The number of blocks to look back when calculating the deposit pool's fill rate (roughly 7 days)
+const fillRateLookbackBlocks uint64 = 7 * 24 * 60 * 5
+
+func getQueuePositions(c *cli.Context) (*api.MinipoolQueuePositionsResponse, error) {
+
+	// Get services
+	if err := services.RequireNodeRegistered(c); err != nil {
+		return nil, err
+	}
+	cfg, err := services.GetConfig(c)
+	if err != nil {
+		return nil, err
+	}
+	w, err := services.GetWallet(c)
+	if err != nil {
+		return nil, err
+	}
+	rp, err := services.GetRocketPool(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response
+	response := api.MinipoolQueuePositionsResponse{}
+
+	// Get node account
+	nodeAccount, err := w.GetNodeAccount()
+	if err != nil {
+		return nil, err
+	}
+
+	// Data
+	var wg errgroup.Group
+	var addresses []common.Address
+	var depositPoolBalance *big.Int
+	var queueLength uint64
+	var queueCapacity *big.Int
+
+	// Get data
+	wg.Go(func() error {
+		var err error
+		addresses, err = minipool.GetNodeMinipoolAddresses(rp, nodeAccount.Address, nil)
+		return err
+	})
+	wg.Go(func() error {
+		var err error
+		depositPoolBalance, err = deposit.GetBalance(rp, nil)
+		return err
+	})
+	wg.Go(func() error {
+		var err error
+		queueLength, err = minipool.GetQueueTotalLength(rp, nil)
+		return err
+	})
+	wg.Go(func() error {
+		var err error
+		queueCapacity, err = minipool.GetQueueTotalCapacity(rp, nil)
+		return err
+	})
+	wg.Go(func() error {
+		eventLogInterval, err := cfg.GetEventLogInterval()
+		if err != nil {
+			return fmt.Errorf("error getting event log interval: %w", err)
+		}
+		response.FillRatePerHour, err = getDepositPoolFillRate(rp, big.NewInt(int64(eventLogInterval)))
+		return err
+	})
+
+	// Wait for data
+	if err := wg.Wait(); err != nil {
+		return nil, err
+	}
+
+	// Get the queue positions
+	response.Minipools = make([]api.MinipoolQueuePosition, len(addresses))
+	for i, address := range addresses {
+		i := i
+		address := address
+		wg.Go(func() error {
+			position, err := minipool.GetQueuePositionOfMinipool(rp, address, nil)
+			if err != nil {
+				return err
+			}
+			response.Minipools[i].Address = address
+			if position > 0 {
+				response.Minipools[i].Position = uint64(position)
+			}
+			return nil
+		})
+	}
+	if err := wg.Wait(); err != nil {
+		return nil, err
+	}
+
+	// Estimate when each queued minipool will be assigned, assuming every minipool in the queue needs the average amount of ETH
+	response.EtaAvailable = response.FillRatePerHour.Sign() > 0 && queueLength > 0
+	if response.EtaAvailable {
+		for i, mp := range response.Minipools {
+			if mp.Position == 0 {
+				continue
+			}
+			ethRequired := new(big.Int).Mul(queueCapacity, new(big.Int).SetUint64(mp.Position))
+			ethRequired.Div(ethRequired, new(big.Int).SetUint64(queueLength))
+			ethRequired.Sub(ethRequired, depositPoolBalance)
+			if ethRequired.Sign() <= 0 {
+				continue
+			}
+			seconds := new(big.Int).Mul(ethRequired, big.NewInt(int64(time.Hour/time.Second)))
+			seconds.Div(seconds, response.FillRatePerHour)
+			response.Minipools[i].TimeUntilAssigned = time.Duration(seconds.Int64()) * time.Second
+		}
+	}
+
+	// Return response
+	return &response, nil
+
+}
+
+// Get the average amount of ETH deposited into the deposit pool per hour over the lookback period
+func getDepositPoolFillRate(rp *rocketpool.RocketPool, intervalSize *big.Int) (*big.Int, error) {
+
+	// Get the lookback range
+	currentBlock, err := rp.Client.BlockNumber(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("error getting the latest block number: %w", err)
+	}
+	lookback := fillRateLookbackBlocks
+	if lookback > currentBlock {
+		lookback = currentBlock
+	}
+	fromBlock := currentBlock - lookback
+	fromHeader, err := rp.Client.HeaderByNumber(context.Background(), new(big.Int).SetUint64(fromBlock))
+	if err != nil {
+		return nil, fmt.Errorf("error getting header for block %d: %w", fromBlock, err)
+	}
+	currentHeader, err := rp.Client.HeaderByNumber(context.Background(), new(big.Int).SetUint64(currentBlock))
+	if err != nil {
+		return nil, fmt.Errorf("error getting header for block %d: %w", currentBlock, err)
+	}
+	elapsedHours := (currentHeader.Time - fromHeader.Time) / uint64(time.Hour/time.Second)
+	if elapsedHours == 0 {
+		return big.NewInt(0), nil
+	}
+
+	// Get the deposits in the range
+	rocketDepositPool, err := rp.GetContract("rocketDepositPool", nil)
+	if err != nil {
+		return nil, err
+	}
+	depositEvent, exists := rocketDepositPool.ABI.Events["DepositReceived"]
+	if !exists {
+		return nil, fmt.Errorf("the deposit pool contract does not have a DepositReceived event")
+	}
+	logs, err := eth.GetLogs(rp, []common.Address{*rocketDepositPool.Address}, [][]common.Hash{{depositEvent.ID}}, intervalSize, new(big.Int).SetUint64(fromBlock), new(big.Int).SetUint64(currentBlock), nil)
+	if err != nil {
+		return nil, fmt.Errorf("error getting deposit events: %w", err)
+	}
+
+	// Sum them up
+	total := big.NewInt(0)
+	for _, log := range logs {
+		values, err := depositEvent.Inputs.NonIndexed().Unpack(log.Data)
+		if err != nil {
+			return nil, fmt.Errorf("error decoding deposit event in transaction %s: %w", log.TxHash.Hex(), err)
+		}
+		if len(values) == 0 {
+			continue
+		}
+		amount, ok := values[0].(*big.Int)
+		if !ok {
+			return nil, fmt.Errorf("unexpected deposit amount type %T in transaction %s", values[0], log.TxHash.Hex())
+		}
+		total.Add(total, amount)
+	}
+
+	return total.Div(total, new(big.Int).SetUint64(elapsedHours)), nil
+
+}
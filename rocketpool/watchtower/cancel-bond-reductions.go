@@ -234,7 +234,7 @@ func (t *cancelBondReductions) cancelBondReduction(address common.Address, reaso
 	}
 
 	// Print the gas info
-	maxFee := eth.GweiToWei(utils.GetWatchtowerMaxFee(t.cfg))
+	maxFee := eth.GweiToWei(utils.GetWatchtowerMaxFee(t.cfg, utils.TaskCancelBondReductions, &t.log))
 	if !api.PrintAndCheckGasInfo(gasInfo, false, 0, &t.log, maxFee, 0) {
 		return
 	}
@@ -1,15 +1,24 @@
 package watchtower
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"math/big"
+	"sort"
+	"strings"
 	"time"
 
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/rocket-pool/rocketpool-go/minipool"
 	"github.com/rocket-pool/rocketpool-go/rocketpool"
 	rptypes "github.com/rocket-pool/rocketpool-go/types"
 	"github.com/rocket-pool/rocketpool-go/utils/eth"
+	"github.com/rocket-pool/rocketpool-go/utils/multicall"
+	rpstate "github.com/rocket-pool/rocketpool-go/utils/state"
 	"github.com/urfave/cli"
 
 	"github.com/rocket-pool/smartnode/rocketpool/watchtower/utils"
@@ -19,10 +28,15 @@ import (
 	"github.com/rocket-pool/smartnode/shared/services/wallet"
 	"github.com/rocket-pool/smartnode/shared/utils/api"
 	"github.com/rocket-pool/smartnode/shared/utils/log"
+	rputils "github.com/rocket-pool/smartnode/shared/utils/rp"
+	txutils "github.com/rocket-pool/smartnode/shared/utils/tx"
 )
 
 // Settings
-const MinipoolStatusBatchSize = 20
+const (
+	MinipoolStatusBatchSize = 20
+	MaxDissolveReplacements = 3
+)
 
 // Dissolve timed out minipools task
 type dissolveTimedOutMinipools struct {
@@ -32,6 +46,9 @@ type dissolveTimedOutMinipools struct {
 	w   *wallet.Wallet
 	ec  rocketpool.ExecutionClient
 	rp  *rocketpool.RocketPool
+
+	// Minipools that should never be dissolved
+	excludedMinipools map[common.Address]bool
 }
 
 // Create dissolve timed out minipools task
@@ -55,14 +72,21 @@ func newDissolveTimedOutMinipools(c *cli.Context, logger log.ColorLogger) (*diss
 		return nil, err
 	}
 
+	// Get the minipools to exclude
+	excludedMinipools, err := parseDissolveExclusions(cfg.Smartnode.WatchtowerDissolveExclusions.Value.(string))
+	if err != nil {
+		return nil, err
+	}
+
 	// Return task
 	return &dissolveTimedOutMinipools{
-		c:   c,
-		log: logger,
-		cfg: cfg,
-		w:   w,
-		ec:  ec,
-		rp:  rp,
+		c:                 c,
+		log:               logger,
+		cfg:               cfg,
+		w:                 w,
+		ec:                ec,
+		rp:                rp,
+		excludedMinipools: excludedMinipools,
 	}, nil
 
 }
@@ -89,9 +113,34 @@ func (t *dissolveTimedOutMinipools) run(state *state.NetworkState) error {
 	// Log
 	t.log.Printlnf("%d minipool(s) have timed out and will be dissolved...", len(minipools))
 
+	// Make sure the node wallet can pay for the dissolves
+	nodeAccount, err := t.w.GetNodeAccount()
+	if err != nil {
+		return err
+	}
+	hasBalance, err := utils.HasWatchtowerMinBalance(t.cfg, t.ec, nodeAccount.Address, utils.TaskDissolveTimedOutMinipools, &t.log)
+	if err != nil {
+		return err
+	}
+	if !hasBalance {
+		return nil
+	}
+
+	// Batch the dissolves into a single transaction if gas is expensive enough
+	if t.shouldBatchDissolves(minipools) {
+		if err := t.dissolveMinipoolsBatched(minipools); err != nil {
+			t.log.Println(fmt.Errorf("Could not dissolve minipools in a batch: %w", err))
+		}
+		return nil
+	}
+
 	// Dissolve minipools
 	for _, mp := range minipools {
-		if err := t.dissolveMinipool(mp); err != nil {
+		err := t.dissolveMinipool(mp)
+		var timeoutErr *api.TransactionTimeoutError
+		if errors.As(err, &timeoutErr) {
+			t.log.Printlnf("The dissolve transaction for minipool %s was not included within %s; it is still pending with hash %s.", mp.GetAddress().Hex(), timeoutErr.Timeout, timeoutErr.Hash.Hex())
+		} else if err != nil {
 			t.log.Println(fmt.Errorf("Could not dissolve minipool %s: %w", mp.GetAddress().Hex(), err))
 		}
 	}
@@ -108,30 +157,94 @@ func (t *dissolveTimedOutMinipools) getTimedOutMinipools(state *state.NetworkSta
 		BlockNumber: big.NewInt(0).SetUint64(state.ElBlockNumber),
 	}
 
-	timedOutMinipools := []minipool.Minipool{}
+	// Get the grace period
+	gracePeriod := time.Duration(t.cfg.Smartnode.WatchtowerDissolveGracePeriod.Value.(uint64)) * time.Minute
+	if gracePeriod > 0 {
+		t.log.Printlnf("Applying a grace period of %s past the launch timeout.", gracePeriod)
+	}
+
+	// Remove the excluded minipools
+	timedOutDetails := []*rpstate.NativeMinipoolDetails{}
+	for _, mpd := range getTimedOutMinipoolDetails(state, gracePeriod) {
+		if t.excludedMinipools[mpd.MinipoolAddress] {
+			t.log.Printlnf("Minipool %s has timed out but is on the dissolve exclusion list, skipping it.", mpd.MinipoolAddress.Hex())
+			continue
+		}
+		timedOutDetails = append(timedOutDetails, mpd)
+	}
+
+	// Only dissolve as many as the per-run limit allows, leaving the rest for later runs
+	maxDissolves := t.cfg.Smartnode.WatchtowerMaxDissolvesPerRun.Value.(uint64)
+	timedOutDetails, deferred := limitTimedOutMinipools(timedOutDetails, maxDissolves)
+	if deferred > 0 {
+		t.log.Printlnf("Deferring %d timed out minipool(s) to a later run because of the limit of %d dissolves per run.", deferred, maxDissolves)
+	}
+
+	// Create minipool bindings
+	timedOutMinipools := make([]minipool.Minipool, 0, len(timedOutDetails))
+	for _, mpd := range timedOutDetails {
+		mp, err := minipool.NewMinipoolFromVersion(t.rp, mpd.MinipoolAddress, mpd.Version, opts)
+		if err != nil {
+			return nil, fmt.Errorf("error creating binding for minipool %s: %w", mpd.MinipoolAddress.Hex(), err)
+		}
+		timedOutMinipools = append(timedOutMinipools, mp)
+	}
+
+	// Return
+	return timedOutMinipools, nil
+
+}
+
+// Parse the comma-separated list of minipool addresses to never dissolve
+func parseDissolveExclusions(value string) (map[common.Address]bool, error) {
+	exclusions := map[common.Address]bool{}
+	for _, element := range strings.Split(value, ",") {
+		address := strings.TrimSpace(element)
+		if address == "" {
+			continue
+		}
+		if !common.IsHexAddress(address) {
+			return nil, fmt.Errorf("Invalid minipool address '%s' in the dissolve exclusion list", address)
+		}
+		exclusions[common.HexToAddress(address)] = true
+	}
+	return exclusions, nil
+}
+
+// Get the details of prelaunch minipools that have passed the launch timeout plus the grace period
+func getTimedOutMinipoolDetails(state *state.NetworkState, gracePeriod time.Duration) []*rpstate.NativeMinipoolDetails {
+
+	timedOutMinipools := []*rpstate.NativeMinipoolDetails{}
 	genesisTime := time.Unix(int64(state.BeaconConfig.GenesisTime), 0)
 	secondsSinceGenesis := time.Duration(state.BeaconSlotNumber*state.BeaconConfig.SecondsPerSlot) * time.Second
 	blockTime := genesisTime.Add(secondsSinceGenesis)
 
 	// Filter minipools by status
 	launchTimeoutBig := state.NetworkDetails.MinipoolLaunchTimeout
-	launchTimeout := time.Duration(launchTimeoutBig.Uint64()) * time.Second
-	for _, mpd := range state.MinipoolDetails {
+	launchTimeout := time.Duration(launchTimeoutBig.Uint64())*time.Second + gracePeriod
+	for i, mpd := range state.MinipoolDetails {
 		statusTime := time.Unix(mpd.StatusTime.Int64(), 0)
-		if mpd.Status == rptypes.Prelaunch && blockTime.Sub(statusTime) >= launchTimeout {
-			mp, err := minipool.NewMinipoolFromVersion(t.rp, mpd.MinipoolAddress, mpd.Version, opts)
-			if err != nil {
-				return nil, fmt.Errorf("error creating binding for minipool %s: %w", mpd.MinipoolAddress.Hex(), err)
-			}
-			timedOutMinipools = append(timedOutMinipools, mp)
+		if mpd.Status == rptypes.Prelaunch && rputils.GetTimeUntil(statusTime.Add(launchTimeout), blockTime) == 0 {
+			timedOutMinipools = append(timedOutMinipools, &state.MinipoolDetails[i])
 		}
 	}
 
-	// Return
-	return timedOutMinipools, nil
+	return timedOutMinipools
 
 }
 
+// Sort timed out minipools from the oldest to the newest and keep at most the provided number of them, returning how many were left out.
+// A limit of 0 keeps all of them.
+func limitTimedOutMinipools(details []*rpstate.NativeMinipoolDetails, limit uint64) ([]*rpstate.NativeMinipoolDetails, int) {
+	sort.SliceStable(details, func(i, j int) bool {
+		return details[i].StatusTime.Cmp(details[j].StatusTime) < 0
+	})
+	if limit == 0 || uint64(len(details)) <= limit {
+		return details, 0
+	}
+	return details[:limit], len(details) - int(limit)
+}
+
 // Dissolve a minipool
 func (t *dissolveTimedOutMinipools) dissolveMinipool(mp minipool.Minipool) error {
 
@@ -151,16 +264,30 @@ func (t *dissolveTimedOutMinipools) dissolveMinipool(mp minipool.Minipool) error
 	}
 
 	// Print the gas info
-	maxFee := eth.GweiToWei(utils.GetWatchtowerMaxFee(t.cfg))
+	maxFee, prioFee := utils.GetWatchtowerGasFees(t.cfg, t.ec, utils.TaskDissolveTimedOutMinipools, &t.log)
 	if !api.PrintAndCheckGasInfo(gasInfo, false, 0, &t.log, maxFee, 0) {
 		return nil
 	}
 
 	// Set the gas settings
 	opts.GasFeeCap = maxFee
-	opts.GasTipCap = eth.GweiToWei(utils.GetWatchtowerPrioFee(t.cfg))
+	opts.GasTipCap = prioFee
 	opts.GasLimit = gasInfo.SafeGasLimit
 
+	// Wait for the TX, replacing it if it gets stuck
+	stuckTimeout := time.Duration(t.cfg.Smartnode.WatchtowerStuckTxTimeout.Value.(uint64)) * time.Minute
+	if stuckTimeout > 0 {
+		feeCeiling := eth.GweiToWei(utils.GetWatchtowerMaxFee(t.cfg, utils.TaskDissolveTimedOutMinipools, nil))
+		dissolved, err := t.dissolveMinipoolWithReplacement(mp, opts, feeCeiling, stuckTimeout)
+		if err != nil {
+			return err
+		}
+		if dissolved {
+			t.log.Printlnf("Successfully dissolved minipool %s.", mp.GetAddress().Hex())
+		}
+		return nil
+	}
+
 	// Dissolve
 	hash, err := mp.Dissolve(opts)
 	if err != nil {
@@ -180,3 +307,225 @@ func (t *dissolveTimedOutMinipools) dissolveMinipool(mp minipool.Minipool) error
 	return nil
 
 }
+
+// Dissolve a minipool, replacing the transaction with a higher fee each time it's pending for longer than the timeout.
+// Replacements never go over the fee ceiling; once they would have to, the latest transaction is left pending.
+// Returns whether the minipool was dissolved.
+func (t *dissolveTimedOutMinipools) dissolveMinipoolWithReplacement(mp minipool.Minipool, opts *bind.TransactOpts, feeCeiling *big.Int, stuckTimeout time.Duration) (bool, error) {
+
+	// Pin the nonce so replacements can reuse it
+	nonce, err := t.rp.Client.PendingNonceAt(context.Background(), opts.From)
+	if err != nil {
+		return false, fmt.Errorf("Could not get the pending nonce: %w", err)
+	}
+	opts.Nonce = new(big.Int).SetUint64(nonce)
+	bumpPercent := t.cfg.Smartnode.WatchtowerStuckTxBumpPercent.Value.(uint64)
+
+	// Dissolve
+	hash, err := mp.Dissolve(opts)
+	if err != nil {
+		return false, err
+	}
+
+	// Every transaction sent with the nonce, since any of them can be the one that gets mined
+	hashes := []common.Hash{hash}
+	for replacements := 0; ; replacements++ {
+		// Wait for one of the TXs to be included in a block
+		t.log.Printlnf("Transaction has been submitted with hash %s.", hash.Hex())
+		_, err := txutils.WaitForNonceWithTimeout(t.rp.Client, opts.From, nonce, hashes, stuckTimeout)
+		if err == nil {
+			return true, nil
+		}
+		if errors.Is(err, txutils.ErrNonceUsed) {
+			return false, fmt.Errorf("nonce %d was used by a different transaction before minipool %s was dissolved", nonce, mp.GetAddress().Hex())
+		}
+		if !errors.Is(err, txutils.ErrTransactionStuck) {
+			return false, fmt.Errorf("Error waiting for transaction: %w", err)
+		}
+		if replacements >= MaxDissolveReplacements {
+			return false, fmt.Errorf("transaction %s is still pending after %d replacements", hash.Hex(), replacements)
+		}
+
+		// Build the replacement
+		t.log.Printlnf("Transaction %s has been pending for over %s, replacing it with a higher fee...", hash.Hex(), stuckTimeout)
+		replacementOpts, err := txutils.GetReplacementTransactOpts(t.rp.Client, opts, hash, nonce, bumpPercent, feeCeiling)
+		if errors.Is(err, txutils.ErrFeeCeilingReached) {
+			t.log.Printlnf("The fees of transaction %s can't be raised any further without going over the max fee of %.6f Gwei; leaving it pending.", hash.Hex(), eth.WeiToGwei(feeCeiling))
+			return false, nil
+		}
+		if err != nil {
+			// One of the transactions may have been mined in the meantime, which also stops the latest one from being replaced
+			receipt, minedErr := txutils.GetMinedTransaction(t.rp.Client, opts.From, nonce, hashes)
+			if minedErr != nil {
+				return false, fmt.Errorf("Error checking for mined transactions: %w", minedErr)
+			}
+			if receipt != nil {
+				return true, nil
+			}
+			return false, fmt.Errorf("Could not create a replacement transaction: %w", err)
+		}
+		t.log.Printlnf("Replacing with a max fee of %.6f Gwei and a priority fee of %.6f Gwei.", eth.WeiToGwei(replacementOpts.GasFeeCap), eth.WeiToGwei(replacementOpts.GasTipCap))
+
+		// Send it
+		opts = replacementOpts
+		hash, err = mp.Dissolve(opts)
+		if err != nil {
+			return false, err
+		}
+		hashes = append(hashes, hash)
+	}
+
+}
+
+// Check if the dissolves should be aggregated into a single multicall transaction based on the current gas price
+func (t *dissolveTimedOutMinipools) shouldBatchDissolves(minipools []minipool.Minipool) bool {
+	threshold := t.cfg.Smartnode.WatchtowerDissolveBatchThreshold.Value.(float64)
+	if threshold <= 0 || len(minipools) < 2 || t.cfg.Smartnode.GetMulticallAddress() == "" {
+		return false
+	}
+
+	gasPrice, err := t.ec.SuggestGasPrice(context.Background())
+	if err != nil {
+		t.log.Printlnf("WARNING: couldn't get the current gas price, dissolving minipools individually: %s", err.Error())
+		return false
+	}
+	if gasPrice.Cmp(eth.GweiToWei(threshold)) <= 0 {
+		return false
+	}
+
+	t.log.Printlnf("Current gas price (%.2f gwei) is above the batch threshold (%.2f gwei), dissolving minipools in a single transaction.", eth.WeiToGwei(gasPrice), threshold)
+	return true
+}
+
+// Dissolve multiple minipools in a single transaction via the multicall contract
+func (t *dissolveTimedOutMinipools) dissolveMinipoolsBatched(minipools []minipool.Minipool) error {
+
+	// Get the node account
+	nodeAccount, err := t.w.GetNodeAccount()
+	if err != nil {
+		return err
+	}
+
+	// Create the multicall contract binding
+	multicallAddress := common.HexToAddress(t.cfg.Smartnode.GetMulticallAddress())
+	multicallAbi, err := abi.JSON(strings.NewReader(multicall.MulticallABI))
+	if err != nil {
+		return fmt.Errorf("error parsing multicall ABI: %w", err)
+	}
+	contract := &rocketpool.Contract{
+		Contract: bind.NewBoundContract(multicallAddress, multicallAbi, t.ec, t.ec, t.ec),
+		Address:  &multicallAddress,
+		ABI:      &multicallAbi,
+		Client:   t.ec,
+	}
+
+	// Build the dissolve calls
+	calls := make([]multicall.MultiCall, len(minipools))
+	for i, mp := range minipools {
+		callData, err := mp.GetContract().ABI.Pack("dissolve")
+		if err != nil {
+			return fmt.Errorf("error creating dissolve call for minipool %s: %w", mp.GetAddress().Hex(), err)
+		}
+		calls[i] = multicall.MultiCall{
+			Target:   mp.GetAddress(),
+			CallData: callData,
+		}
+	}
+
+	// Simulate the batch so each minipool that would fail can be reported and removed from it
+	simData, err := multicallAbi.Pack("tryAggregate", false, calls)
+	if err != nil {
+		return fmt.Errorf("error packing multicall data: %w", err)
+	}
+	response, err := t.ec.CallContract(context.Background(), ethereum.CallMsg{From: nodeAccount.Address, To: &multicallAddress, Data: simData}, nil)
+	if err != nil {
+		return fmt.Errorf("error simulating multicall: %w", err)
+	}
+	unpacked, err := multicallAbi.Unpack("tryAggregate", response)
+	if err != nil {
+		return fmt.Errorf("error decoding multicall simulation: %w", err)
+	}
+	results := unpacked[0].([]struct {
+		Success    bool   `json:"success"`
+		ReturnData []byte `json:"returnData"`
+	})
+
+	batchMinipools := []minipool.Minipool{}
+	batchCalls := []multicall.MultiCall{}
+	for i, result := range results {
+		mp := minipools[i]
+		if !result.Success {
+			reason, err := abi.UnpackRevert(result.ReturnData)
+			if err != nil {
+				reason = "unknown reason"
+			}
+			t.log.Println(fmt.Errorf("Could not dissolve minipool %s: simulation reverted (%s)", mp.GetAddress().Hex(), reason))
+			continue
+		}
+		batchMinipools = append(batchMinipools, mp)
+		batchCalls = append(batchCalls, calls[i])
+	}
+
+	if len(batchMinipools) == 0 {
+		return nil
+	}
+	if len(batchMinipools) == 1 {
+		return t.dissolveMinipool(batchMinipools[0])
+	}
+
+	// Log
+	t.log.Printlnf("Dissolving %d minipools in a single transaction...", len(batchMinipools))
+
+	// Get transactor
+	opts, err := t.w.GetNodeAccountTransactor()
+	if err != nil {
+		return err
+	}
+
+	// Get the gas limit
+	gasInfo, err := contract.GetTransactionGasInfo(opts, "tryAggregate", false, batchCalls)
+	if err != nil {
+		return fmt.Errorf("Could not estimate the gas required to dissolve the minipools: %w", err)
+	}
+
+	// Print the gas info
+	maxFee, prioFee := utils.GetWatchtowerGasFees(t.cfg, t.ec, utils.TaskDissolveTimedOutMinipools, &t.log)
+	if !api.PrintAndCheckGasInfo(gasInfo, false, 0, &t.log, maxFee, 0) {
+		return nil
+	}
+
+	// Set the gas settings
+	opts.GasFeeCap = maxFee
+	opts.GasTipCap = prioFee
+	opts.GasLimit = gasInfo.SafeGasLimit
+
+	// Dissolve
+	tx, err := contract.Transact(opts, "tryAggregate", false, batchCalls)
+	if err != nil {
+		return err
+	}
+
+	// Print TX info and wait for it to be included in a block
+	err = api.PrintAndWaitForTransaction(t.cfg, tx.Hash(), t.rp.Client, &t.log)
+	if err != nil {
+		return err
+	}
+
+	// The calls were allowed to fail individually, so check which minipools were actually dissolved
+	for _, mp := range batchMinipools {
+		status, err := mp.GetStatus(nil)
+		if err != nil {
+			t.log.Println(fmt.Errorf("Could not check the status of minipool %s after dissolving: %w", mp.GetAddress().Hex(), err))
+			continue
+		}
+		if status != rptypes.Dissolved {
+			t.log.Println(fmt.Errorf("Could not dissolve minipool %s: its status is still %s", mp.GetAddress().Hex(), status.String()))
+			continue
+		}
+		t.log.Printlnf("Successfully dissolved minipool %s.", mp.GetAddress().Hex())
+	}
+
+	// Return
+	return nil
+
+}
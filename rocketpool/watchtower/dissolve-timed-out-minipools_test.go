@@ -0,0 +1,84 @@
+package watchtower
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	rptypes "github.com/rocket-pool/rocketpool-go/types"
+	rpstate "github.com/rocket-pool/rocketpool-go/utils/state"
+
+	"github.com/rocket-pool/smartnode/shared/services/beacon"
+	"github.com/rocket-pool/smartnode/shared/services/state"
+)
+
+func TestGracePeriodSkipsRecentlyTimedOutMinipools(t *testing.T) {
+	// A minipool that passed the launch timeout 10 minutes ago, and one that passed it 2 hours ago
+	launchTimeout := 72 * time.Hour
+	blockTime := time.Unix(1700000000, 0)
+	recentStatusTime := blockTime.Add(-launchTimeout - 10*time.Minute)
+	oldStatusTime := blockTime.Add(-launchTimeout - 2*time.Hour)
+
+	networkState := &state.NetworkState{
+		BeaconSlotNumber: uint64(blockTime.Unix()) / 12,
+		BeaconConfig: beacon.Eth2Config{
+			GenesisTime:    uint64(blockTime.Unix()) % 12,
+			SecondsPerSlot: 12,
+		},
+		NetworkDetails: &rpstate.NetworkDetails{
+			MinipoolLaunchTimeout: big.NewInt(int64(launchTimeout.Seconds())),
+		},
+		MinipoolDetails: []rpstate.NativeMinipoolDetails{
+			{
+				MinipoolAddress: common.BytesToAddress([]byte{1}),
+				Status:          rptypes.Prelaunch,
+				StatusTime:      big.NewInt(recentStatusTime.Unix()),
+			},
+			{
+				MinipoolAddress: common.BytesToAddress([]byte{2}),
+				Status:          rptypes.Prelaunch,
+				StatusTime:      big.NewInt(oldStatusTime.Unix()),
+			},
+		},
+	}
+
+	// Without a grace period, both should be dissolved
+	timedOut := getTimedOutMinipoolDetails(networkState, 0)
+	if len(timedOut) != 2 {
+		t.Fatalf("expected 2 timed out minipools without a grace period but got %d", len(timedOut))
+	}
+
+	// With a 1 hour grace period, only the old one should be dissolved
+	timedOut = getTimedOutMinipoolDetails(networkState, time.Hour)
+	if len(timedOut) != 1 {
+		t.Fatalf("expected 1 timed out minipool with a grace period but got %d", len(timedOut))
+	}
+	if timedOut[0].MinipoolAddress != common.BytesToAddress([]byte{2}) {
+		t.Fatalf("expected minipool %s to be dissolved but got %s", common.BytesToAddress([]byte{2}).Hex(), timedOut[0].MinipoolAddress.Hex())
+	}
+}
+
+func TestDissolveLimitKeepsOldestMinipools(t *testing.T) {
+	// Three timed out minipools, out of order by status time
+	details := []*rpstate.NativeMinipoolDetails{
+		{MinipoolAddress: common.BytesToAddress([]byte{1}), StatusTime: big.NewInt(300)},
+		{MinipoolAddress: common.BytesToAddress([]byte{2}), StatusTime: big.NewInt(100)},
+		{MinipoolAddress: common.BytesToAddress([]byte{3}), StatusTime: big.NewInt(200)},
+	}
+
+	// Without a limit, all of them should be kept
+	kept, deferred := limitTimedOutMinipools(details, 0)
+	if len(kept) != 3 || deferred != 0 {
+		t.Fatalf("expected 3 kept and 0 deferred without a limit but got %d and %d", len(kept), deferred)
+	}
+
+	// With a limit of 2, the two oldest should be kept
+	kept, deferred = limitTimedOutMinipools(details, 2)
+	if len(kept) != 2 || deferred != 1 {
+		t.Fatalf("expected 2 kept and 1 deferred with a limit but got %d and %d", len(kept), deferred)
+	}
+	if kept[0].MinipoolAddress != common.BytesToAddress([]byte{2}) || kept[1].MinipoolAddress != common.BytesToAddress([]byte{3}) {
+		t.Fatalf("expected minipools %s and %s to be kept but got %s and %s", common.BytesToAddress([]byte{2}).Hex(), common.BytesToAddress([]byte{3}).Hex(), kept[0].MinipoolAddress.Hex(), kept[1].MinipoolAddress.Hex())
+	}
+}
@@ -589,7 +589,7 @@ func (t *submitRplPrice) submitRplPrice(blockNumber uint64, rplPrice *big.Int) e
 	}
 
 	// Print the gas info
-	maxFee := eth.GweiToWei(utils.GetWatchtowerMaxFee(t.cfg))
+	maxFee := eth.GweiToWei(utils.GetWatchtowerMaxFee(t.cfg, utils.TaskSubmitRplPrice, &t.log))
 	if !api.PrintAndCheckGasInfo(gasInfo, false, 0, &t.log, maxFee, 0) {
 		return nil
 	}
@@ -727,7 +727,7 @@ func (t *submitRplPrice) submitOptimismPrice() error {
 		}
 
 		// Print the gas info
-		maxFee := eth.GweiToWei(utils.GetWatchtowerMaxFee(t.cfg))
+		maxFee := eth.GweiToWei(utils.GetWatchtowerMaxFee(t.cfg, utils.TaskSubmitRplPrice, &t.log))
 		if !api.PrintAndCheckGasInfo(gasInfo, false, 0, &t.log, maxFee, 0) {
 			return nil
 		}
@@ -867,7 +867,7 @@ func (t *submitRplPrice) submitPolygonPrice() error {
 		}
 
 		// Print the gas info
-		maxFee := eth.GweiToWei(utils.GetWatchtowerMaxFee(t.cfg))
+		maxFee := eth.GweiToWei(utils.GetWatchtowerMaxFee(t.cfg, utils.TaskSubmitRplPrice, &t.log))
 		if !api.PrintAndCheckGasInfo(gasInfo, false, 0, &t.log, maxFee, 0) {
 			return nil
 		}
@@ -1030,7 +1030,7 @@ func (t *submitRplPrice) submitArbitrumPrice(priceMessengerAddress string) error
 		}
 
 		// Print the gas info
-		maxFee := eth.GweiToWei(utils.GetWatchtowerMaxFee(t.cfg))
+		maxFee := eth.GweiToWei(utils.GetWatchtowerMaxFee(t.cfg, utils.TaskSubmitRplPrice, &t.log))
 		if !api.PrintAndCheckGasInfo(gasInfo, false, 0, &t.log, maxFee, 0) {
 			return nil
 		}
@@ -1143,7 +1143,7 @@ func (t *submitRplPrice) submitZkSyncEraPrice() error {
 		fairL2GasPrice := eth.GweiToWei(0.5)
 		l2GasLimit := big.NewInt(750000)
 		gasPerPubdataByte := big.NewInt(800)
-		maxFee := eth.GweiToWei(utils.GetWatchtowerMaxFee(t.cfg))
+		maxFee := eth.GweiToWei(utils.GetWatchtowerMaxFee(t.cfg, utils.TaskSubmitRplPrice, &t.log))
 
 		// Value calculation on zkSync Era
 		pubdataPrice := big.NewInt(0).Mul(l1GasPerPubdataByte, maxFee)
@@ -1328,7 +1328,7 @@ func (t *submitRplPrice) submitBasePrice() error {
 		}
 
 		// Print the gas info
-		maxFee := eth.GweiToWei(utils.GetWatchtowerMaxFee(t.cfg))
+		maxFee := eth.GweiToWei(utils.GetWatchtowerMaxFee(t.cfg, utils.TaskSubmitRplPrice, &t.log))
 		if !api.PrintAndCheckGasInfo(gasInfo, false, 0, &t.log, maxFee, 0) {
 			return nil
 		}
@@ -1493,7 +1493,7 @@ func (t *submitRplPrice) submitScrollPrice() error {
 		}
 
 		// Print the gas info
-		maxFee := eth.GweiToWei(utils.GetWatchtowerMaxFee(t.cfg))
+		maxFee := eth.GweiToWei(utils.GetWatchtowerMaxFee(t.cfg, utils.TaskSubmitRplPrice, &t.log))
 		if !api.PrintAndCheckGasInfo(gasInfo, false, 0, &t.log, maxFee, 0) {
 			return nil
 		}
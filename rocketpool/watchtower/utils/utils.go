@@ -1,6 +1,22 @@
 package utils
 
-import "github.com/rocket-pool/smartnode/shared/services/config"
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rocket-pool/rocketpool-go/rocketpool"
+	"github.com/rocket-pool/rocketpool-go/utils/eth"
+
+	"github.com/rocket-pool/smartnode/shared/services/config"
+	"github.com/rocket-pool/smartnode/shared/services/gas"
+	cfgtypes "github.com/rocket-pool/smartnode/shared/types/config"
+	"github.com/rocket-pool/smartnode/shared/utils/log"
+)
 
 const (
 	MinWatchtowerMaxFee        float64 = 200
@@ -9,12 +25,55 @@ const (
 	RewardsSubmissionForcedGas uint64  = 64000
 )
 
-// Get the max fee for watchtower transactions
-func GetWatchtowerMaxFee(cfg *config.RocketPoolConfig) float64 {
-	setting := cfg.Smartnode.WatchtowerMaxFeeOverride.Value.(float64)
+// Watchtower task IDs, used to look up per-task max fee overrides
+const (
+	TaskCancelBondReductions      string = "cancel-bond-reductions"
+	TaskCheckSoloMigrations       string = "check-solo-migrations"
+	TaskDissolveTimedOutMinipools string = "dissolve-timed-out-minipools"
+	TaskRespondChallenges         string = "respond-challenges"
+	TaskSubmitNetworkBalances     string = "submit-network-balances"
+	TaskSubmitRewardsTree         string = "submit-rewards-tree"
+	TaskSubmitRplPrice            string = "submit-rpl-price"
+	TaskSubmitScrubMinipools      string = "submit-scrub-minipools"
+)
+
+// All of the watchtower task IDs that can have a max fee override
+var knownTasks = []string{
+	TaskCancelBondReductions,
+	TaskCheckSoloMigrations,
+	TaskDissolveTimedOutMinipools,
+	TaskRespondChallenges,
+	TaskSubmitNetworkBalances,
+	TaskSubmitRewardsTree,
+	TaskSubmitRplPrice,
+	TaskSubmitScrubMinipools,
+}
+
+// Get the max fee for a watchtower task's transactions, using the task's override if it has one and the global max fee otherwise
+func GetWatchtowerMaxFee(cfg *config.RocketPoolConfig, task string, logger *log.ColorLogger) float64 {
+	globalMaxFee := cfg.Smartnode.WatchtowerMaxFeeOverride.Value.(float64)
+	if globalMaxFee < MinWatchtowerMaxFee {
+		globalMaxFee = MinWatchtowerMaxFee
+	}
+
+	overrides, err := parseTaskMaxFees(cfg.Smartnode.WatchtowerTaskMaxFees.Value.(string))
+	if err != nil {
+		logPrintlnf(logger, "WARNING: ignoring the watchtower task max fees: %s", err.Error())
+		overrides = nil
+	}
+	for _, unknownTask := range getUnknownTasks(overrides) {
+		logPrintlnf(logger, "WARNING: ignoring the watchtower max fee for unknown task [%s]; valid tasks are %s.", unknownTask, strings.Join(knownTasks, ", "))
+	}
+	setting, exists := overrides[task]
+	if !exists {
+		logPrintlnf(logger, "Using the global watchtower max fee of %.2f gwei for %s.", globalMaxFee, task)
+		return globalMaxFee
+	}
+
 	if setting < MinWatchtowerMaxFee {
-		return MinWatchtowerMaxFee
+		setting = MinWatchtowerMaxFee
 	}
+	logPrintlnf(logger, "Using the task max fee of %.2f gwei for %s.", setting, task)
 	return setting
 }
 
@@ -26,3 +85,107 @@ func GetWatchtowerPrioFee(cfg *config.RocketPoolConfig) float64 {
 	}
 	return setting
 }
+
+// Get the max fee and priority fee for a watchtower task's transactions, in wei.
+// If dynamic gas sources are enabled, the fees are sized from them and capped at the task's max fee; otherwise the max fee and
+// priority fee settings are used as-is.
+func GetWatchtowerGasFees(cfg *config.RocketPoolConfig, ec rocketpool.ExecutionClient, task string, logger *log.ColorLogger) (*big.Int, *big.Int) {
+	maxFee := eth.GweiToWei(GetWatchtowerMaxFee(cfg, task, logger))
+	prioFee := eth.GweiToWei(GetWatchtowerPrioFee(cfg))
+	mode := cfg.Smartnode.WatchtowerGasSources.Value.(cfgtypes.GasSourceMode)
+	if mode != cfgtypes.GasSourceMode_ExecutionClient && mode != cfgtypes.GasSourceMode_ExecutionClientAndOracle {
+		return maxFee, prioFee
+	}
+
+	recommendation, err := gas.GetBlendedGasRecommendation(ec, mode == cfgtypes.GasSourceMode_ExecutionClientAndOracle)
+	if err != nil {
+		logPrintlnf(logger, "WARNING: couldn't get a gas recommendation, using the configured fees instead: %s", err.Error())
+		return maxFee, prioFee
+	}
+	logPrintlnf(logger, "Gas sources: suggested priority fee %.2f gwei, recent base fee %.2f gwei.", eth.WeiToGwei(recommendation.SuggestedTipWei), eth.WeiToGwei(recommendation.BaseFeeWei))
+	if recommendation.OracleFeeWei != nil {
+		logPrintlnf(logger, "Gas sources: oracle rapid gas price %.2f gwei.", eth.WeiToGwei(recommendation.OracleFeeWei))
+	} else if recommendation.OracleErr != nil {
+		logPrintlnf(logger, "WARNING: couldn't use the gas oracle: %s", recommendation.OracleErr.Error())
+	}
+
+	// Never go above the configured ceiling, or below the minimum priority fee
+	if recommendation.MaxFeeWei.Cmp(maxFee) < 0 {
+		maxFee = recommendation.MaxFeeWei
+	}
+	if recommendation.PriorityFeeWei.Cmp(prioFee) > 0 {
+		prioFee = recommendation.PriorityFeeWei
+	}
+	if prioFee.Cmp(maxFee) > 0 {
+		prioFee = maxFee
+	}
+	logPrintlnf(logger, "Using a max fee of %.2f gwei and a priority fee of %.2f gwei.", eth.WeiToGwei(maxFee), eth.WeiToGwei(prioFee))
+	return maxFee, prioFee
+}
+
+// Check whether the node wallet has at least the configured minimum ETH balance for a watchtower task's transactions.
+// If it doesn't, a warning is logged and the task should skip its run.
+func HasWatchtowerMinBalance(cfg *config.RocketPoolConfig, ec rocketpool.ExecutionClient, nodeAddress common.Address, task string, logger *log.ColorLogger) (bool, error) {
+	minBalance := cfg.Smartnode.WatchtowerMinBalance.Value.(float64)
+	if minBalance <= 0 {
+		return true, nil
+	}
+
+	balance, err := ec.BalanceAt(context.Background(), nodeAddress, nil)
+	if err != nil {
+		return false, fmt.Errorf("error getting ETH balance of node %s: %w", nodeAddress.Hex(), err)
+	}
+	if balance.Cmp(eth.EthToWei(minBalance)) < 0 {
+		logPrintlnf(logger, "WARNING: the node wallet only has %.6f ETH, which is below the watchtower minimum balance of %.6f ETH; skipping %s until it is topped up.", eth.WeiToEth(balance), minBalance, task)
+		return false, nil
+	}
+	return true, nil
+}
+
+// Parse a comma-separated list of task=fee pairs into a map of task IDs to max fees (in gwei)
+func parseTaskMaxFees(value string) (map[string]float64, error) {
+	fees := map[string]float64{}
+	for _, element := range strings.Split(value, ",") {
+		element = strings.TrimSpace(element)
+		if element == "" {
+			continue
+		}
+		task, feeString, found := strings.Cut(element, "=")
+		task = strings.TrimSpace(task)
+		if !found || task == "" {
+			return nil, fmt.Errorf("invalid entry [%s]; entries must be in the form task=fee", element)
+		}
+		fee, err := strconv.ParseFloat(strings.TrimSpace(feeString), 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid max fee for task %s: %w", task, err)
+		}
+		fees[task] = fee
+	}
+	return fees, nil
+}
+
+// Get the task IDs in a set of max fee overrides that don't belong to any watchtower task, in sorted order
+func getUnknownTasks(fees map[string]float64) []string {
+	unknownTasks := []string{}
+	for task := range fees {
+		isKnown := false
+		for _, knownTask := range knownTasks {
+			if task == knownTask {
+				isKnown = true
+				break
+			}
+		}
+		if !isKnown {
+			unknownTasks = append(unknownTasks, task)
+		}
+	}
+	sort.Strings(unknownTasks)
+	return unknownTasks
+}
+
+// Print a line to the logger if there is one
+func logPrintlnf(logger *log.ColorLogger, format string, v ...interface{}) {
+	if logger != nil {
+		logger.Printlnf(format, v...)
+	}
+}
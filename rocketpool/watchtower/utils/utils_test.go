@@ -0,0 +1,63 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/rocket-pool/smartnode/shared/services/config"
+)
+
+func TestParseTaskMaxFees(t *testing.T) {
+	fees, err := parseTaskMaxFees(" submit-rpl-price=300, ,respond-challenges = 250.5 ")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if len(fees) != 2 || fees[TaskSubmitRplPrice] != 300 || fees[TaskRespondChallenges] != 250.5 {
+		t.Fatalf("expected fees for 2 tasks, got %v", fees)
+	}
+
+	fees, err = parseTaskMaxFees("")
+	if err != nil || len(fees) != 0 {
+		t.Fatalf("expected no fees for an empty setting, got %v and %v", fees, err)
+	}
+}
+
+func TestParseTaskMaxFeesRejectsInvalidEntries(t *testing.T) {
+	for _, value := range []string{"submit-rpl-price", "=300", "submit-rpl-price=fast"} {
+		if _, err := parseTaskMaxFees(value); err == nil {
+			t.Fatalf("expected an error parsing [%s]", value)
+		}
+	}
+}
+
+func TestUnknownTasksAreReported(t *testing.T) {
+	fees, err := parseTaskMaxFees("submit-rpl-price=300,submit-rpl-prices=300,dissolve-minipools=500")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	unknownTasks := getUnknownTasks(fees)
+	if len(unknownTasks) != 2 || unknownTasks[0] != "dissolve-minipools" || unknownTasks[1] != "submit-rpl-prices" {
+		t.Fatalf("expected 2 unknown tasks, got %v", unknownTasks)
+	}
+}
+
+func TestGetWatchtowerMaxFee(t *testing.T) {
+	cfg := config.NewRocketPoolConfig("", false)
+	cfg.Smartnode.WatchtowerMaxFeeOverride.Value = float64(250)
+	cfg.Smartnode.WatchtowerTaskMaxFees.Value = "submit-rpl-price=400,respond-challenges=50,submit-rpl-prices=900"
+
+	if fee := GetWatchtowerMaxFee(cfg, TaskSubmitRplPrice, nil); fee != 400 {
+		t.Fatalf("expected the task override of 400, got %.2f", fee)
+	}
+	if fee := GetWatchtowerMaxFee(cfg, TaskRespondChallenges, nil); fee != MinWatchtowerMaxFee {
+		t.Fatalf("expected a task override below the minimum to be raised to %.2f, got %.2f", MinWatchtowerMaxFee, fee)
+	}
+	if fee := GetWatchtowerMaxFee(cfg, TaskSubmitNetworkBalances, nil); fee != 250 {
+		t.Fatalf("expected the global max fee of 250, got %.2f", fee)
+	}
+
+	// A malformed setting falls back to the global max fee for every task
+	cfg.Smartnode.WatchtowerTaskMaxFees.Value = "submit-rpl-price"
+	if fee := GetWatchtowerMaxFee(cfg, TaskSubmitRplPrice, nil); fee != 250 {
+		t.Fatalf("expected the global max fee of 250 for a malformed setting, got %.2f", fee)
+	}
+}
@@ -109,10 +109,20 @@ func run(c *cli.Context) error {
 	errorLog := log.NewColorLogger(ErrorColor)
 	updateLog := log.NewColorLogger(UpdateColor)
 
-	// Create the state manager
-	m, err := state.NewNetworkStateManager(rp, cfg, rp.Client, bc, &updateLog)
+	// Warn if the Beacon client isn't configured for the network's forks
+	if err := services.CheckForkSchedule(c); err != nil {
+		errorLog.Printlnf("WARNING: %s", err.Error())
+	}
+
+	// Create the state manager; the Beacon config is retrieved on first use so startup doesn't fail if the Beacon node isn't ready yet
+	m := state.NewLazyNetworkStateManager(rp, cfg, rp.Client, bc, &updateLog)
+
+	// Send the state builds to the state read EC if there is one
+	stateReadRp, stateReadEc, err := services.GetStateReadClient(c)
 	if err != nil {
-		return err
+		errorLog.Printlnf("WARNING: couldn't use the state read EC, using the primary EC for state builds instead: %s", err.Error())
+	} else if stateReadRp != nil {
+		m.UseStateReadClient(stateReadRp, stateReadEc)
 	}
 
 	// Get the node address
@@ -0,0 +1,38 @@
+package services
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/rocket-pool/smartnode/shared/services/beacon"
+	"github.com/rocket-pool/smartnode/shared/services/beacon/client"
+	"github.com/rocket-pool/smartnode/shared/services/config"
+)
+
+// Create the primary Beacon client and the fallback one, if there is one.
+// Each client only gets its own bearer token and TLS client certificate, so one provider's credentials are never sent to the other.
+func newBeaconClients(cfg *config.SmartnodeConfig, primaryProvider string, fallbackProvider string) (beacon.Client, beacon.Client, error) {
+	primaryAuth := client.StandardHttpClientAuth{
+		BearerToken: cfg.BeaconApiToken.Value.(string),
+		TlsCertPath: os.ExpandEnv(cfg.BeaconTlsCertPath.Value.(string)),
+		TlsKeyPath:  os.ExpandEnv(cfg.BeaconTlsKeyPath.Value.(string)),
+	}
+	primaryBc, err := client.NewStandardHttpClientWithAuth(primaryProvider, primaryAuth)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error creating primary Beacon client: %w", err)
+	}
+	if fallbackProvider == "" {
+		return primaryBc, nil, nil
+	}
+
+	fallbackAuth := client.StandardHttpClientAuth{
+		BearerToken: cfg.FallbackBeaconApiToken.Value.(string),
+		TlsCertPath: os.ExpandEnv(cfg.FallbackBeaconTlsCertPath.Value.(string)),
+		TlsKeyPath:  os.ExpandEnv(cfg.FallbackBeaconTlsKeyPath.Value.(string)),
+	}
+	fallbackBc, err := client.NewStandardHttpClientWithAuth(fallbackProvider, fallbackAuth)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error creating fallback Beacon client: %w", err)
+	}
+	return primaryBc, fallbackBc, nil
+}
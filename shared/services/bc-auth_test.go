@@ -0,0 +1,75 @@
+package services
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/rocket-pool/smartnode/shared/services/config"
+	cfgtypes "github.com/rocket-pool/smartnode/shared/types/config"
+)
+
+// Creates a test Beacon node that rejects requests without the provided bearer token
+func newBearerTokenCheckingServer(token string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer "+token {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data":{"is_syncing":false,"head_slot":"100","sync_distance":"0"}}`))
+	}))
+}
+
+// Create a config with the provided Beacon API tokens and no TLS client certificates
+func newBeaconAuthConfig(primaryToken string, fallbackToken string) *config.SmartnodeConfig {
+	return &config.SmartnodeConfig{
+		BeaconApiToken:            cfgtypes.Parameter{Value: primaryToken},
+		BeaconTlsCertPath:         cfgtypes.Parameter{Value: ""},
+		BeaconTlsKeyPath:          cfgtypes.Parameter{Value: ""},
+		FallbackBeaconApiToken:    cfgtypes.Parameter{Value: fallbackToken},
+		FallbackBeaconTlsCertPath: cfgtypes.Parameter{Value: ""},
+		FallbackBeaconTlsKeyPath:  cfgtypes.Parameter{Value: ""},
+	}
+}
+
+func TestPrimaryAndFallbackBeaconAuthAreSeparate(t *testing.T) {
+	primaryServer := newBearerTokenCheckingServer("primary-token")
+	defer primaryServer.Close()
+	fallbackServer := newBearerTokenCheckingServer("fallback-token")
+	defer fallbackServer.Close()
+
+	cfg := newBeaconAuthConfig("primary-token", "fallback-token")
+	primaryBc, fallbackBc, err := newBeaconClients(cfg, primaryServer.URL, fallbackServer.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := primaryBc.GetSyncStatus(); err != nil {
+		t.Fatalf("the primary Beacon client didn't get its own token: %s", err.Error())
+	}
+	if _, err := fallbackBc.GetSyncStatus(); err != nil {
+		t.Fatalf("the fallback Beacon client didn't get its own token: %s", err.Error())
+	}
+
+	// Each client's token must not be sent to the other
+	swappedPrimary, swappedFallback, err := newBeaconClients(cfg, fallbackServer.URL, primaryServer.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := swappedPrimary.GetSyncStatus(); err == nil {
+		t.Fatal("the primary Beacon client's token was accepted by the fallback provider")
+	}
+	if _, err := swappedFallback.GetSyncStatus(); err == nil {
+		t.Fatal("the fallback Beacon client's token was accepted by the primary provider")
+	}
+}
+
+func TestFallbackBeaconClientIsOptional(t *testing.T) {
+	primaryBc, fallbackBc, err := newBeaconClients(newBeaconAuthConfig("primary-token", ""), "http://localhost:5052", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if primaryBc == nil || fallbackBc != nil {
+		t.Fatal("expected only a primary Beacon client")
+	}
+}
@@ -8,7 +8,6 @@ import (
 	"github.com/fatih/color"
 	"github.com/rocket-pool/rocketpool-go/types"
 	"github.com/rocket-pool/smartnode/shared/services/beacon"
-	"github.com/rocket-pool/smartnode/shared/services/beacon/client"
 	"github.com/rocket-pool/smartnode/shared/services/config"
 	"github.com/rocket-pool/smartnode/shared/types/api"
 	cfgtypes "github.com/rocket-pool/smartnode/shared/types/config"
@@ -74,11 +73,9 @@ func NewBeaconClientManager(cfg *config.RocketPoolConfig) (*BeaconClientManager,
 		}
 	}
 
-	var primaryBc beacon.Client
-	var fallbackBc beacon.Client
-	primaryBc = client.NewStandardHttpClient(primaryProvider)
-	if fallbackProvider != "" {
-		fallbackBc = client.NewStandardHttpClient(fallbackProvider)
+	primaryBc, fallbackBc, err := newBeaconClients(cfg.Smartnode, primaryProvider, fallbackProvider)
+	if err != nil {
+		return nil, err
 	}
 
 	return &BeaconClientManager{
@@ -139,6 +136,17 @@ func (m *BeaconClientManager) GetEth2DepositContract() (beacon.Eth2DepositContra
 	return result.(beacon.Eth2DepositContract), nil
 }
 
+// Get the fork schedule the Beacon client is configured with
+func (m *BeaconClientManager) GetForkSchedule() ([]beacon.ForkInfo, error) {
+	result, err := m.runFunction1(func(client beacon.Client) (interface{}, error) {
+		return client.GetForkSchedule()
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.([]beacon.ForkInfo), nil
+}
+
 // Get the attestations in a Beacon chain block
 func (m *BeaconClientManager) GetAttestations(blockId string) ([]beacon.AttestationInfo, bool, error) {
 	result1, result2, err := m.runFunction2(func(client beacon.Client) (interface{}, interface{}, error) {
@@ -237,6 +245,23 @@ func (m *BeaconClientManager) GetValidatorSyncDuties(indices []string, epoch uin
 	return result.(map[string]bool), nil
 }
 
+// Get whether each validator was seen participating in an epoch, and whether the client supports liveness checks at all
+func (m *BeaconClientManager) GetValidatorLiveness(indices []string, epoch uint64) (map[string]bool, bool, error) {
+	type livenessResult struct {
+		liveness  map[string]bool
+		supported bool
+	}
+	result, err := m.runFunction1(func(client beacon.Client) (interface{}, error) {
+		liveness, supported, err := client.GetValidatorLiveness(indices, epoch)
+		return livenessResult{liveness, supported}, err
+	})
+	if err != nil {
+		return nil, false, err
+	}
+	livenessResults := result.(livenessResult)
+	return livenessResults.liveness, livenessResults.supported, nil
+}
+
 // Get a validator's proposer duties
 func (m *BeaconClientManager) GetValidatorProposerDuties(indices []string, epoch uint64) (map[string]uint64, error) {
 	result, err := m.runFunction1(func(client beacon.Client) (interface{}, error) {
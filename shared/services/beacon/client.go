@@ -31,6 +31,11 @@ type Eth2DepositContract struct {
 	ChainID uint64
 	Address common.Address
 }
+type ForkInfo struct {
+	PreviousVersion []byte
+	CurrentVersion  []byte
+	Epoch           uint64
+}
 type BeaconHead struct {
 	Epoch                  uint64
 	FinalizedEpoch         uint64
@@ -136,6 +141,7 @@ type Client interface {
 	GetSyncStatus() (SyncStatus, error)
 	GetEth2Config() (Eth2Config, error)
 	GetEth2DepositContract() (Eth2DepositContract, error)
+	GetForkSchedule() ([]ForkInfo, error)
 	GetAttestations(blockId string) ([]AttestationInfo, bool, error)
 	GetBeaconBlock(blockId string) (BeaconBlock, bool, error)
 	GetBeaconBlockHeader(blockId string) (BeaconBlockHeader, bool, error)
@@ -146,6 +152,7 @@ type Client interface {
 	GetValidatorIndex(pubkey types.ValidatorPubkey) (string, error)
 	GetValidatorSyncDuties(indices []string, epoch uint64) (map[string]bool, error)
 	GetValidatorProposerDuties(indices []string, epoch uint64) (map[string]uint64, error)
+	GetValidatorLiveness(indices []string, epoch uint64) (map[string]bool, bool, error)
 	GetDomainData(domainType []byte, epoch uint64, useGenesisFork bool) ([]byte, error)
 	ExitValidator(validatorIndex string, epoch uint64, signature types.ValidatorSignature) error
 	Close() error
@@ -2,6 +2,7 @@ package client
 
 import (
 	"bytes"
+	"crypto/tls"
 	"encoding/hex"
 	"fmt"
 	"io"
@@ -31,6 +32,7 @@ const (
 	RequestSyncStatusPath                  = "/eth/v1/node/syncing"
 	RequestEth2ConfigPath                  = "/eth/v1/config/spec"
 	RequestEth2DepositContractMethod       = "/eth/v1/config/deposit_contract"
+	RequestForkSchedulePath                = "/eth/v1/config/fork_schedule"
 	RequestGenesisPath                     = "/eth/v1/beacon/genesis"
 	RequestCommitteePath                   = "/eth/v1/beacon/states/%s/committees"
 	RequestFinalityCheckpointsPath         = "/eth/v1/beacon/states/%s/finality_checkpoints"
@@ -42,22 +44,62 @@ const (
 	RequestBeaconBlockHeaderPath           = "/eth/v1/beacon/headers/%s"
 	RequestValidatorSyncDuties             = "/eth/v1/validator/duties/sync/%s"
 	RequestValidatorProposerDuties         = "/eth/v1/validator/duties/proposer/%s"
+	RequestValidatorLivenessPath           = "/eth/v1/validator/liveness/%s"
 	RequestWithdrawalCredentialsChangePath = "/eth/v1/beacon/pool/bls_to_execution_changes"
 
 	MaxRequestValidatorsCount     = 600
 	threadLimit               int = 12
 )
 
+// Authentication options for Beacon nodes that sit behind an authenticated proxy
+type StandardHttpClientAuth struct {
+	// A bearer token to send in the Authorization header of every request
+	BearerToken string
+
+	// The paths of a TLS client certificate and its private key, used for mutual TLS
+	TlsCertPath string
+	TlsKeyPath  string
+}
+
 // Beacon client using the standard Beacon HTTP REST API (https://ethereum.github.io/beacon-APIs/)
 type StandardHttpClient struct {
 	providerAddress string
+	bearerToken     string
+	client          *http.Client
 }
 
 // Create a new client instance
 func NewStandardHttpClient(providerAddress string) *StandardHttpClient {
 	return &StandardHttpClient{
 		providerAddress: providerAddress,
+		client:          http.DefaultClient,
+	}
+}
+
+// Create a new client instance that authenticates with the Beacon node
+func NewStandardHttpClientWithAuth(providerAddress string, auth StandardHttpClientAuth) (*StandardHttpClient, error) {
+	client := NewStandardHttpClient(providerAddress)
+	client.bearerToken = auth.BearerToken
+
+	// Load the TLS client certificate if one was provided
+	if auth.TlsCertPath != "" || auth.TlsKeyPath != "" {
+		if auth.TlsCertPath == "" || auth.TlsKeyPath == "" {
+			return nil, fmt.Errorf("both a TLS client certificate and key must be provided")
+		}
+		cert, err := tls.LoadX509KeyPair(auth.TlsCertPath, auth.TlsKeyPath)
+		if err != nil {
+			return nil, fmt.Errorf("error loading Beacon TLS client certificate: %w", err)
+		}
+		transport := http.DefaultTransport.(*http.Transport).Clone()
+		transport.TLSClientConfig = &tls.Config{
+			Certificates: []tls.Certificate{cert},
+		}
+		client.client = &http.Client{
+			Transport: transport,
+		}
 	}
+
+	return client, nil
 }
 
 // Close the client connection
@@ -147,6 +189,27 @@ func (c *StandardHttpClient) GetEth2DepositContract() (beacon.Eth2DepositContrac
 	}, nil
 }
 
+// Get the fork schedule the client is configured with
+func (c *StandardHttpClient) GetForkSchedule() ([]beacon.ForkInfo, error) {
+
+	// Get the fork schedule
+	forkSchedule, err := c.getForkSchedule()
+	if err != nil {
+		return nil, err
+	}
+
+	// Return response
+	forks := make([]beacon.ForkInfo, len(forkSchedule.Data))
+	for i, fork := range forkSchedule.Data {
+		forks[i] = beacon.ForkInfo{
+			PreviousVersion: fork.PreviousVersion,
+			CurrentVersion:  fork.CurrentVersion,
+			Epoch:           uint64(fork.Epoch),
+		}
+	}
+	return forks, nil
+}
+
 // Get the beacon head
 func (c *StandardHttpClient) GetBeaconHead() (beacon.BeaconHead, error) {
 
@@ -345,6 +408,41 @@ func (c *StandardHttpClient) GetValidatorSyncDuties(indices []string, epoch uint
 	return validatorMap, nil
 }
 
+// Get whether each validator was seen participating in the given epoch.
+// Liveness is an optional part of the Beacon API, so this also returns whether the client supports it.
+func (c *StandardHttpClient) GetValidatorLiveness(indices []string, epoch uint64) (map[string]bool, bool, error) {
+
+	// Perform the post request
+	responseBody, status, err := c.postRequest(fmt.Sprintf(RequestValidatorLivenessPath, strconv.FormatUint(epoch, 10)), indices)
+	if err != nil {
+		return nil, false, fmt.Errorf("Could not get validator liveness: %w", err)
+	}
+	if status == http.StatusNotFound || status == http.StatusMethodNotAllowed || status == http.StatusNotImplemented {
+		return nil, false, nil
+	}
+	if status != http.StatusOK {
+		return nil, false, fmt.Errorf("Could not get validator liveness: HTTP status %d; response body: '%s'", status, string(responseBody))
+	}
+
+	var response ValidatorLivenessResponse
+	if err := json.Unmarshal(responseBody, &response); err != nil {
+		return nil, false, fmt.Errorf("Could not decode validator liveness data: %w", err)
+	}
+
+	// Map the results
+	validatorMap := make(map[string]bool, len(indices))
+	for _, index := range indices {
+		validatorMap[index] = false
+	}
+	for _, liveness := range response.Data {
+		if _, exists := validatorMap[liveness.Index]; exists {
+			validatorMap[liveness.Index] = liveness.IsLive
+		}
+	}
+
+	return validatorMap, true, nil
+}
+
 // Sums proposer duties per validators for a given epoch
 func (c *StandardHttpClient) GetValidatorProposerDuties(indices []string, epoch uint64) (map[string]uint64, error) {
 
@@ -624,6 +722,22 @@ func (c *StandardHttpClient) getEth2DepositContract() (Eth2DepositContractRespon
 	return eth2DepositContract, nil
 }
 
+// Get the fork schedule
+func (c *StandardHttpClient) getForkSchedule() (ForkScheduleResponse, error) {
+	responseBody, status, err := c.getRequest(RequestForkSchedulePath)
+	if err != nil {
+		return ForkScheduleResponse{}, fmt.Errorf("Could not get fork schedule: %w", err)
+	}
+	if status != http.StatusOK {
+		return ForkScheduleResponse{}, fmt.Errorf("Could not get fork schedule: HTTP status %d; response body: '%s'", status, string(responseBody))
+	}
+	var forkSchedule ForkScheduleResponse
+	if err := json.Unmarshal(responseBody, &forkSchedule); err != nil {
+		return ForkScheduleResponse{}, fmt.Errorf("Could not decode fork schedule: %w", err)
+	}
+	return forkSchedule, nil
+}
+
 // Get genesis information
 func (c *StandardHttpClient) getGenesis() (GenesisResponse, error) {
 	responseBody, status, err := c.getRequest(RequestGenesisPath)
@@ -914,8 +1028,15 @@ func (c *StandardHttpClient) postWithdrawalCredentialsChange(request BLSToExecut
 // Make a GET request but do not read its body yet (allows buffered decoding)
 func (c *StandardHttpClient) getRequestReader(requestPath string) (io.ReadCloser, int, error) {
 
+	// Create the request
+	request, err := http.NewRequest(http.MethodGet, fmt.Sprintf(RequestUrlFormat, c.providerAddress, requestPath), nil)
+	if err != nil {
+		return nil, 0, err
+	}
+	c.addAuthHeader(request)
+
 	// Send request
-	response, err := http.Get(fmt.Sprintf(RequestUrlFormat, c.providerAddress, requestPath))
+	response, err := c.client.Do(request)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -955,8 +1076,16 @@ func (c *StandardHttpClient) postRequest(requestPath string, requestBody interfa
 	}
 	requestBodyReader := bytes.NewReader(requestBodyBytes)
 
+	// Create the request
+	request, err := http.NewRequest(http.MethodPost, fmt.Sprintf(RequestUrlFormat, c.providerAddress, requestPath), requestBodyReader)
+	if err != nil {
+		return []byte{}, 0, err
+	}
+	request.Header.Set("Content-Type", RequestContentType)
+	c.addAuthHeader(request)
+
 	// Send request
-	response, err := http.Post(fmt.Sprintf(RequestUrlFormat, c.providerAddress, requestPath), RequestContentType, requestBodyReader)
+	response, err := c.client.Do(request)
 	if err != nil {
 		return []byte{}, 0, err
 	}
@@ -974,3 +1103,10 @@ func (c *StandardHttpClient) postRequest(requestPath string, requestBody interfa
 	return body, response.StatusCode, nil
 
 }
+
+// Add the bearer token to a request if one is set
+func (c *StandardHttpClient) addAuthHeader(request *http.Request) {
+	if c.bearerToken != "" {
+		request.Header.Set("Authorization", "Bearer "+c.bearerToken)
+	}
+}
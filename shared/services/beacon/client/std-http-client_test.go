@@ -0,0 +1,81 @@
+package client
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+const testBearerToken = "test-token"
+
+// Creates a test Beacon node that rejects requests without the expected bearer token
+func newTokenCheckingServer() *httptest.Server {
+	mux := http.NewServeMux()
+	mux.HandleFunc(RequestEth2ConfigPath, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"data":{"SECONDS_PER_SLOT":"12","SLOTS_PER_EPOCH":"32","EPOCHS_PER_SYNC_COMMITTEE_PERIOD":"256"}}`))
+	})
+	mux.HandleFunc(RequestGenesisPath, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"data":{"genesis_time":"1606824023","genesis_fork_version":"0x00000000","genesis_validators_root":"0x4b363db94e286120d76eb905340fdd4e54bfe9f06bf33ff6cf5ad27f511bfe95"}}`))
+	})
+	mux.HandleFunc("/eth/v2/beacon/blocks/", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer "+testBearerToken {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		mux.ServeHTTP(w, r)
+	}))
+}
+
+func TestBearerTokenIsSent(t *testing.T) {
+	server := newTokenCheckingServer()
+	defer server.Close()
+
+	client, err := NewStandardHttpClientWithAuth(server.URL, StandardHttpClientAuth{
+		BearerToken: testBearerToken,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	config, err := client.GetEth2Config()
+	if err != nil {
+		t.Fatalf("error getting eth2 config: %s", err.Error())
+	}
+	if config.SecondsPerSlot != 12 || config.SlotsPerEpoch != 32 {
+		t.Fatalf("unexpected eth2 config: %+v", config)
+	}
+
+	_, exists, err := client.GetBeaconBlock("1")
+	if err != nil {
+		t.Fatalf("error getting Beacon block: %s", err.Error())
+	}
+	if exists {
+		t.Fatal("expected the Beacon block to be missing")
+	}
+}
+
+func TestMissingBearerTokenIsRejected(t *testing.T) {
+	server := newTokenCheckingServer()
+	defer server.Close()
+
+	client := NewStandardHttpClient(server.URL)
+	if _, err := client.GetEth2Config(); err == nil {
+		t.Fatal("expected the request without a token to be rejected")
+	}
+	if _, _, err := client.GetBeaconBlock("1"); err == nil {
+		t.Fatal("expected the request without a token to be rejected")
+	}
+}
+
+func TestIncompleteTlsConfigIsRejected(t *testing.T) {
+	_, err := NewStandardHttpClientWithAuth("http://localhost", StandardHttpClientAuth{
+		TlsCertPath: "client.crt",
+	})
+	if err == nil {
+		t.Fatal("expected a TLS certificate without a key to be rejected")
+	}
+}
@@ -78,6 +78,13 @@ type ForkResponse struct {
 		Epoch           uinteger  `json:"epoch"`
 	} `json:"data"`
 }
+type ForkScheduleResponse struct {
+	Data []struct {
+		PreviousVersion byteArray `json:"previous_version"`
+		CurrentVersion  byteArray `json:"current_version"`
+		Epoch           uinteger  `json:"epoch"`
+	} `json:"data"`
+}
 type AttestationsResponse struct {
 	Data []Attestation `json:"data"`
 }
@@ -140,6 +147,13 @@ type SyncDuty struct {
 	ValidatorIndex       string     `json:"validator_index"`
 	SyncCommitteeIndices []uinteger `json:"validator_sync_committee_indices"`
 }
+type ValidatorLivenessResponse struct {
+	Data []ValidatorLiveness `json:"data"`
+}
+type ValidatorLiveness struct {
+	Index  string `json:"index"`
+	IsLive bool   `json:"is_live"`
+}
 type ProposerDutiesResponse struct {
 	Data []ProposerDuty `json:"data"`
 }
@@ -1526,6 +1526,72 @@ func (cfg *RocketPoolConfig) Validate() []string {
 	return errors
 }
 
+// Checks the current configuration for settings that are allowed but probably aren't what the user wants; returns a list of warnings
+func (cfg *RocketPoolConfig) GetWarnings() []string {
+	warnings := []string{}
+
+	// Check that the fallback clients are different from the primary ones
+	warnings = cfg.checkForDuplicateFallbackUrls(warnings)
+
+	return warnings
+}
+
+// Check if the fallback clients have the same URLs as the primary clients, in which case they don't provide any redundancy
+func (cfg *RocketPoolConfig) checkForDuplicateFallbackUrls(warnings []string) []string {
+	if cfg.UseFallbackClients.Value != true {
+		return warnings
+	}
+
+	// Get the primary URLs; locally-managed clients run in containers, so they can't be duplicated by a fallback
+	var primaryEcUrl string
+	var primaryCcUrl string
+	if cfg.IsNativeMode {
+		primaryEcUrl = cfg.Native.EcHttpUrl.Value.(string)
+		primaryCcUrl = cfg.Native.CcHttpUrl.Value.(string)
+	} else {
+		if cfg.ExecutionClientMode.Value.(config.Mode) == config.Mode_External {
+			primaryEcUrl = cfg.ExternalExecution.HttpUrl.Value.(string)
+		}
+		if cfg.ConsensusClientMode.Value.(config.Mode) == config.Mode_External {
+			ccCfg, err := cfg.GetSelectedConsensusClientConfig()
+			if err == nil {
+				if externalCcCfg, ok := ccCfg.(config.ExternalConsensusConfig); ok {
+					primaryCcUrl = externalCcCfg.GetApiUrl()
+				}
+			}
+		}
+	}
+
+	// Get the fallback URLs
+	fallbackEcUrl := cfg.FallbackNormal.EcHttpUrl.Value.(string)
+	fallbackCcUrl := cfg.FallbackNormal.CcHttpUrl.Value.(string)
+	if !cfg.IsNativeMode {
+		cc, _ := cfg.GetSelectedConsensusClient()
+		if cc == config.ConsensusClient_Prysm {
+			fallbackEcUrl = cfg.FallbackPrysm.EcHttpUrl.Value.(string)
+			fallbackCcUrl = cfg.FallbackPrysm.CcHttpUrl.Value.(string)
+		}
+	}
+
+	if isSameClientUrl(primaryEcUrl, fallbackEcUrl) {
+		warnings = append(warnings, fmt.Sprintf("Your fallback Execution client has the same URL as your primary Execution client (%s), so it won't provide any redundancy. You should use a different Execution client for your fallback.", primaryEcUrl))
+	}
+	if isSameClientUrl(primaryCcUrl, fallbackCcUrl) {
+		warnings = append(warnings, fmt.Sprintf("Your fallback Consensus client has the same URL as your primary Consensus client (%s), so it won't provide any redundancy. You should use a different Consensus client for your fallback.", primaryCcUrl))
+	}
+	return warnings
+}
+
+// Check if two client URLs point to the same place, ignoring case and trailing slashes
+func isSameClientUrl(first string, second string) bool {
+	first = strings.TrimSuffix(strings.TrimSpace(first), "/")
+	second = strings.TrimSuffix(strings.TrimSpace(second), "/")
+	if first == "" || second == "" {
+		return false
+	}
+	return strings.EqualFold(first, second)
+}
+
 func addAndCheckForDuplicate(portMap map[interface{}]bool, param config.Parameter, errors []string) (map[interface{}]bool, []string) {
 	port := fmt.Sprintf("%v", param.Value)
 	if port == "" {
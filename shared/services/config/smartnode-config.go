@@ -85,12 +85,84 @@ type SmartnodeConfig struct {
 	// URL for an EC with archive mode, for manual rewards tree generation
 	ArchiveECUrl config.Parameter `yaml:"archiveEcUrl,omitempty"`
 
+	// Custom HTTP headers to send with every request to the archive EC
+	ArchiveEcRpcHeaders config.Parameter `yaml:"archiveEcRpcHeaders,omitempty"`
+
+	// URL for an EC used for the heavy reads of network state builds
+	StateReadECUrl config.Parameter `yaml:"stateReadEcUrl,omitempty"`
+
+	// Custom HTTP headers to send with every request to the state read EC
+	StateReadEcRpcHeaders config.Parameter `yaml:"stateReadEcRpcHeaders,omitempty"`
+
 	// Manual override for the watchtower's max fee
 	WatchtowerMaxFeeOverride config.Parameter `yaml:"watchtowerMaxFeeOverride,omitempty"`
 
 	// Manual override for the watchtower's priority fee
 	WatchtowerPrioFeeOverride config.Parameter `yaml:"watchtowerPrioFeeOverride,omitempty"`
 
+	// Per-task overrides for the watchtower's max fee
+	WatchtowerTaskMaxFees config.Parameter `yaml:"watchtowerTaskMaxFees,omitempty"`
+
+	// The sources used to size the watchtower's gas fees dynamically
+	WatchtowerGasSources config.Parameter `yaml:"watchtowerGasSources,omitempty"`
+
+	// The minimum ETH balance the node wallet needs before the watchtower runs tasks that send transactions
+	WatchtowerMinBalance config.Parameter `yaml:"watchtowerMinBalance,omitempty"`
+
+	// The gas price above which the watchtower batches minipool dissolves into a single transaction
+	WatchtowerDissolveBatchThreshold config.Parameter `yaml:"watchtowerDissolveBatchThreshold,omitempty"`
+
+	// Extra time the watchtower waits past the launch timeout before dissolving a minipool
+	WatchtowerDissolveGracePeriod config.Parameter `yaml:"watchtowerDissolveGracePeriod,omitempty"`
+
+	// The maximum number of minipools the watchtower dissolves in a single run
+	WatchtowerMaxDissolvesPerRun config.Parameter `yaml:"watchtowerMaxDissolvesPerRun,omitempty"`
+
+	// Toggle for API routes that are only meant for debugging, such as dumping the network state
+	EnableDebugRoutes config.Parameter `yaml:"enableDebugRoutes,omitempty"`
+
+	// The number of consecutive polls that must see a recent block before the EC is considered synced
+	EcSyncConfirmations config.Parameter `yaml:"ecSyncConfirmations,omitempty"`
+
+	// Minipools the watchtower will never dissolve
+	WatchtowerDissolveExclusions config.Parameter `yaml:"watchtowerDissolveExclusions,omitempty"`
+
+	// How long to wait for a transaction to be included in a block before giving up on it
+	TxInclusionTimeout config.Parameter `yaml:"txInclusionTimeout,omitempty"`
+
+	// How long the watchtower waits for a transaction before replacing it with a higher fee
+	WatchtowerStuckTxTimeout config.Parameter `yaml:"watchtowerStuckTxTimeout,omitempty"`
+
+	// The percentage the watchtower bumps the fees by when replacing a stuck transaction
+	WatchtowerStuckTxBumpPercent config.Parameter `yaml:"watchtowerStuckTxBumpPercent,omitempty"`
+
+	// Custom HTTP headers to send with every request to the primary Execution client
+	EcRpcHeaders config.Parameter `yaml:"ecRpcHeaders,omitempty"`
+
+	// Custom HTTP headers to send with every request to the fallback Execution client
+	FallbackEcRpcHeaders config.Parameter `yaml:"fallbackEcRpcHeaders,omitempty"`
+
+	// Bearer token for authenticating with the primary Beacon node's API
+	BeaconApiToken config.Parameter `yaml:"beaconApiToken,omitempty"`
+
+	// TLS client certificate for authenticating with the primary Beacon node's API
+	BeaconTlsCertPath config.Parameter `yaml:"beaconTlsCertPath,omitempty"`
+
+	// TLS client key for authenticating with the primary Beacon node's API
+	BeaconTlsKeyPath config.Parameter `yaml:"beaconTlsKeyPath,omitempty"`
+
+	// Bearer token for authenticating with the fallback Beacon node's API
+	FallbackBeaconApiToken config.Parameter `yaml:"fallbackBeaconApiToken,omitempty"`
+
+	// TLS client certificate for authenticating with the fallback Beacon node's API
+	FallbackBeaconTlsCertPath config.Parameter `yaml:"fallbackBeaconTlsCertPath,omitempty"`
+
+	// TLS client key for authenticating with the fallback Beacon node's API
+	FallbackBeaconTlsKeyPath config.Parameter `yaml:"fallbackBeaconTlsKeyPath,omitempty"`
+
+	// The address of a node to observe in read-only mode instead of using the node wallet
+	ObserverNodeAddress config.Parameter `yaml:"observerNodeAddress,omitempty"`
+
 	// The toggle for rolling records
 	UseRollingRecords config.Parameter `yaml:"useRollingRecords,omitempty"`
 
@@ -341,6 +413,39 @@ func NewSmartnodeConfig(cfg *RocketPoolConfig) *SmartnodeConfig {
 			OverwriteOnUpgrade: false,
 		},
 
+		ArchiveEcRpcHeaders: config.Parameter{
+			ID:                 "archiveEcRpcHeaders",
+			Name:               "Archive-Mode EC Headers",
+			Description:        "[orange]**For advanced users only.**[white]\n\nIf your Archive-Mode EC is an RPC provider that requires extra HTTP headers (such as an API key), enter them here as a comma-separated list of `Name=Value` pairs. They will only be sent with the requests the Smartnode makes to your Archive-Mode EC.\n\nLeave this blank if it doesn't need any extra headers.",
+			Type:               config.ParameterType_String,
+			Default:            map[config.Network]interface{}{config.Network_All: ""},
+			AffectsContainers:  []config.ContainerID{config.ContainerID_Watchtower},
+			CanBeBlank:         true,
+			OverwriteOnUpgrade: false,
+		},
+
+		StateReadECUrl: config.Parameter{
+			ID:                 "stateReadEcUrl",
+			Name:               "State Read EC URL",
+			Description:        "[orange]**For advanced users only.**[white]\n\nBuilding the network state makes a large number of contract calls, which can put a heavy load on your primary Execution client. If you enter the URL of another Execution client here (such as a dedicated Archive node), the state builds of the node and watchtower daemons will be sent to it instead.\nYour primary Execution client will still be used for sync checks and for submitting transactions.\n\nLeave this blank to use your primary Execution client for everything.",
+			Type:               config.ParameterType_String,
+			Default:            map[config.Network]interface{}{config.Network_All: ""},
+			AffectsContainers:  []config.ContainerID{config.ContainerID_Node, config.ContainerID_Watchtower},
+			CanBeBlank:         true,
+			OverwriteOnUpgrade: false,
+		},
+
+		StateReadEcRpcHeaders: config.Parameter{
+			ID:                 "stateReadEcRpcHeaders",
+			Name:               "State Read EC Headers",
+			Description:        "[orange]**For advanced users only.**[white]\n\nIf your State Read EC is an RPC provider that requires extra HTTP headers (such as an API key), enter them here as a comma-separated list of `Name=Value` pairs. They will only be sent with the requests the Smartnode makes to your State Read EC.\n\nLeave this blank if it doesn't need any extra headers.",
+			Type:               config.ParameterType_String,
+			Default:            map[config.Network]interface{}{config.Network_All: ""},
+			AffectsContainers:  []config.ContainerID{config.ContainerID_Node, config.ContainerID_Watchtower},
+			CanBeBlank:         true,
+			OverwriteOnUpgrade: false,
+		},
+
 		WatchtowerMaxFeeOverride: config.Parameter{
 			ID:                 "watchtowerMaxFeeOverride",
 			Name:               "Watchtower Max Fee Override",
@@ -363,6 +468,250 @@ func NewSmartnodeConfig(cfg *RocketPoolConfig) *SmartnodeConfig {
 			OverwriteOnUpgrade: true,
 		},
 
+		WatchtowerTaskMaxFees: config.Parameter{
+			ID:                 "watchtowerTaskMaxFees",
+			Name:               "Watchtower Task Max Fees",
+			Description:        fmt.Sprintf("[orange]**For Oracle DAO members only.**\n\n[white]Use this to give individual watchtower tasks their own max fee (in gwei), so time-critical tasks can pay more than routine ones during network congestion. Enter them as a comma-separated list of `task=fee` pairs, such as `dissolve-timed-out-minipools=500`. Tasks without an entry use the Watchtower Max Fee Override above. As with that setting, fees below %d will be ignored.\n\nThe tasks are `cancel-bond-reductions`, `check-solo-migrations`, `dissolve-timed-out-minipools`, `respond-challenges`, `submit-network-balances`, `submit-rewards-tree`, `submit-rpl-price`, and `submit-scrub-minipools`.", WatchtowerMaxFeeDefault),
+			Type:               config.ParameterType_String,
+			Default:            map[config.Network]interface{}{config.Network_All: ""},
+			AffectsContainers:  []config.ContainerID{config.ContainerID_Watchtower},
+			CanBeBlank:         true,
+			OverwriteOnUpgrade: false,
+		},
+
+		WatchtowerGasSources: config.Parameter{
+			ID:                 "watchtowerGasSources",
+			Name:               "Watchtower Gas Sources",
+			Description:        "[orange]**For Oracle DAO members only.**\n\n[white]Select how the watchtower sizes the fees of its transactions. The max fee settings above are always used as a ceiling.",
+			Type:               config.ParameterType_Choice,
+			Default:            map[config.Network]interface{}{config.Network_All: config.GasSourceMode_Static},
+			AffectsContainers:  []config.ContainerID{config.ContainerID_Watchtower},
+			CanBeBlank:         false,
+			OverwriteOnUpgrade: false,
+			Options: []config.ParameterOption{{
+				Name:        "Static",
+				Description: "Always use the watchtower max fee and priority fee settings.",
+				Value:       config.GasSourceMode_Static,
+			}, {
+				Name:        "Execution Client",
+				Description: "Use your Execution client's suggested priority fee and the recent base fees to pick the max fee and priority fee.",
+				Value:       config.GasSourceMode_ExecutionClient,
+			}, {
+				Name:        "Execution Client and Oracle",
+				Description: "Like Execution Client, but also use an external gas oracle's rapid gas price, picking whichever max fee is higher.",
+				Value:       config.GasSourceMode_ExecutionClientAndOracle,
+			}},
+		},
+
+		WatchtowerMinBalance: config.Parameter{
+			ID:                 "watchtowerMinBalance",
+			Name:               "Watchtower Minimum Balance",
+			Description:        "[orange]**For Oracle DAO members only.**\n\n[white]The minimum ETH balance your node wallet needs for the watchtower to run tasks that send transactions. If the balance is lower than this, the watchtower will log a warning and skip those tasks instead of letting their transactions fail.\n\nThis is 0 by default, which disables the check.",
+			Type:               config.ParameterType_Float,
+			Default:            map[config.Network]interface{}{config.Network_All: float64(0)},
+			AffectsContainers:  []config.ContainerID{config.ContainerID_Watchtower},
+			CanBeBlank:         false,
+			OverwriteOnUpgrade: false,
+		},
+
+		WatchtowerDissolveBatchThreshold: config.Parameter{
+			ID:                 "watchtowerDissolveBatchThreshold",
+			Name:               "Watchtower Dissolve Batch Threshold",
+			Description:        "[orange]**For Oracle DAO members only.**\n\n[white]When the network's gas price (in gwei) is above this threshold, the watchtower will dissolve all timed out minipools in a single transaction via the multicall contract instead of sending one transaction per minipool.\n\nSet this to 0 to always dissolve minipools individually.",
+			Type:               config.ParameterType_Float,
+			Default:            map[config.Network]interface{}{config.Network_All: float64(0)},
+			AffectsContainers:  []config.ContainerID{config.ContainerID_Watchtower},
+			CanBeBlank:         false,
+			OverwriteOnUpgrade: false,
+		},
+
+		WatchtowerDissolveGracePeriod: config.Parameter{
+			ID:                 "watchtowerDissolveGracePeriod",
+			Name:               "Watchtower Dissolve Grace Period",
+			Description:        "[orange]**For Oracle DAO members only.**\n\n[white]The number of minutes the watchtower will wait after a prelaunch minipool has passed the network's launch timeout before dissolving it. This gives node operators a buffer to submit a late deposit.\n\nSet this to 0 to dissolve minipools as soon as they time out.",
+			Type:               config.ParameterType_Uint,
+			Default:            map[config.Network]interface{}{config.Network_All: uint64(0)},
+			AffectsContainers:  []config.ContainerID{config.ContainerID_Watchtower},
+			CanBeBlank:         false,
+			OverwriteOnUpgrade: false,
+		},
+
+		WatchtowerMaxDissolvesPerRun: config.Parameter{
+			ID:                 "watchtowerMaxDissolvesPerRun",
+			Name:               "Watchtower Max Dissolves Per Run",
+			Description:        "[orange]**For Oracle DAO members only.**\n\n[white]The maximum number of timed out minipools the watchtower will dissolve each time it runs. The oldest ones are dissolved first, and the rest are left for the following runs. Use this to bound the gas and time spent on a large backlog.\n\nSet this to 0 to dissolve every timed out minipool in each run.",
+			Type:               config.ParameterType_Uint,
+			Default:            map[config.Network]interface{}{config.Network_All: uint64(0)},
+			AffectsContainers:  []config.ContainerID{config.ContainerID_Watchtower},
+			CanBeBlank:         false,
+			OverwriteOnUpgrade: false,
+		},
+
+		EnableDebugRoutes: config.Parameter{
+			ID:                 "enableDebugRoutes",
+			Name:               "Enable Debug Routes",
+			Description:        "Enable this to allow API routes that are only meant for troubleshooting, such as dumping the entire network state to a file. These can be slow and resource-intensive, so only enable this if you've been asked to.",
+			Type:               config.ParameterType_Bool,
+			Default:            map[config.Network]interface{}{config.Network_All: false},
+			AffectsContainers:  []config.ContainerID{config.ContainerID_Api},
+			CanBeBlank:         false,
+			OverwriteOnUpgrade: false,
+		},
+
+		EcSyncConfirmations: config.Parameter{
+			ID:                 "ecSyncConfirmations",
+			Name:               "EC Sync Confirmations",
+			Description:        "The number of consecutive times the Smartnode must see a recent block from your Execution client while it's waiting for it to sync before it considers the client synced.\n\nIncrease this if your client briefly reports a recent block and then stalls during its initial sync.",
+			Type:               config.ParameterType_Uint,
+			Default:            map[config.Network]interface{}{config.Network_All: uint64(1)},
+			AffectsContainers:  []config.ContainerID{config.ContainerID_Api, config.ContainerID_Node, config.ContainerID_Watchtower},
+			CanBeBlank:         false,
+			OverwriteOnUpgrade: false,
+		},
+
+		WatchtowerDissolveExclusions: config.Parameter{
+			ID:                 "watchtowerDissolveExclusions",
+			Name:               "Watchtower Dissolve Exclusions",
+			Description:        "[orange]**For Oracle DAO members only.**\n\n[white]A comma-separated list of minipool addresses that the watchtower will never dissolve, even if they have timed out.\n\nLeave this blank to dissolve every timed out minipool.",
+			Type:               config.ParameterType_String,
+			Default:            map[config.Network]interface{}{config.Network_All: ""},
+			AffectsContainers:  []config.ContainerID{config.ContainerID_Watchtower},
+			CanBeBlank:         true,
+			OverwriteOnUpgrade: false,
+		},
+
+		TxInclusionTimeout: config.Parameter{
+			ID:                 "txInclusionTimeout",
+			Name:               "Transaction Inclusion Timeout",
+			Description:        "The number of minutes the node and watchtower processes will wait for one of their transactions to be included in a block before giving up on it and reporting it as still pending. The transaction is not cancelled, so it may still be included later.\n\nSet this to 0 to wait indefinitely.",
+			Type:               config.ParameterType_Uint,
+			Default:            map[config.Network]interface{}{config.Network_All: uint64(0)},
+			AffectsContainers:  []config.ContainerID{config.ContainerID_Node, config.ContainerID_Watchtower},
+			CanBeBlank:         false,
+			OverwriteOnUpgrade: false,
+		},
+
+		WatchtowerStuckTxTimeout: config.Parameter{
+			ID:                 "watchtowerStuckTxTimeout",
+			Name:               "Watchtower Stuck Transaction Timeout",
+			Description:        "[orange]**For Oracle DAO members only.**\n\n[white]The number of minutes the watchtower will wait for a minipool dissolve transaction to be included in a block before replacing it with a new transaction that has a higher fee.\n\nSet this to 0 to always wait for the original transaction.",
+			Type:               config.ParameterType_Uint,
+			Default:            map[config.Network]interface{}{config.Network_All: uint64(0)},
+			AffectsContainers:  []config.ContainerID{config.ContainerID_Watchtower},
+			CanBeBlank:         false,
+			OverwriteOnUpgrade: false,
+		},
+
+		WatchtowerStuckTxBumpPercent: config.Parameter{
+			ID:                 "watchtowerStuckTxBumpPercent",
+			Name:               "Watchtower Stuck Transaction Fee Bump",
+			Description:        "[orange]**For Oracle DAO members only.**\n\n[white]The percentage the watchtower will increase a stuck transaction's max fee and priority fee by when replacing it.\n\nExecution clients require an increase of at least 10% to accept a replacement, so lower values will be treated as 10.",
+			Type:               config.ParameterType_Uint,
+			Default:            map[config.Network]interface{}{config.Network_All: uint64(20)},
+			AffectsContainers:  []config.ContainerID{config.ContainerID_Watchtower},
+			CanBeBlank:         false,
+			OverwriteOnUpgrade: false,
+		},
+
+		EcRpcHeaders: config.Parameter{
+			ID:                 "ecRpcHeaders",
+			Name:               "Execution Client Headers",
+			Description:        "If your primary Execution client is an RPC provider that requires extra HTTP headers (such as an API key), enter them here as a comma-separated list of `Name=Value` pairs. They will only be sent with the requests the Smartnode makes to your primary Execution client; use the Fallback Execution Client Headers for your fallback client.\n\nLeave this blank if your Execution client doesn't need any extra headers.",
+			Type:               config.ParameterType_String,
+			Default:            map[config.Network]interface{}{config.Network_All: ""},
+			AffectsContainers:  []config.ContainerID{config.ContainerID_Api, config.ContainerID_Node, config.ContainerID_Watchtower},
+			CanBeBlank:         true,
+			OverwriteOnUpgrade: false,
+		},
+
+		FallbackEcRpcHeaders: config.Parameter{
+			ID:                 "fallbackEcRpcHeaders",
+			Name:               "Fallback Execution Client Headers",
+			Description:        "If your fallback Execution client is an RPC provider that requires extra HTTP headers (such as an API key), enter them here as a comma-separated list of `Name=Value` pairs. They will only be sent with the requests the Smartnode makes to your fallback Execution client, so your primary client's credentials are never sent to it.\n\nLeave this blank if your fallback Execution client doesn't need any extra headers.",
+			Type:               config.ParameterType_String,
+			Default:            map[config.Network]interface{}{config.Network_All: ""},
+			AffectsContainers:  []config.ContainerID{config.ContainerID_Api, config.ContainerID_Node, config.ContainerID_Watchtower},
+			CanBeBlank:         true,
+			OverwriteOnUpgrade: false,
+		},
+
+		BeaconApiToken: config.Parameter{
+			ID:                 "beaconApiToken",
+			Name:               "Beacon API Token",
+			Description:        "If your primary Beacon node's API is behind a reverse proxy that requires authentication, enter the bearer token here. It will only be sent in the `Authorization` header of the requests the Smartnode makes to your primary Beacon node; use the Fallback Beacon API Token for your fallback node.\n\nLeave this blank if your Beacon node doesn't require authentication.",
+			Type:               config.ParameterType_String,
+			Default:            map[config.Network]interface{}{config.Network_All: ""},
+			AffectsContainers:  []config.ContainerID{config.ContainerID_Api, config.ContainerID_Node, config.ContainerID_Watchtower},
+			CanBeBlank:         true,
+			OverwriteOnUpgrade: false,
+		},
+
+		BeaconTlsCertPath: config.Parameter{
+			ID:                 "beaconTlsCertPath",
+			Name:               "Beacon TLS Client Certificate",
+			Description:        "If your primary Beacon node's API requires mutual TLS, enter the path of the client certificate (in PEM format) here. The path must be accessible to the Smartnode's daemon.\n\nLeave this blank if your Beacon node doesn't require a client certificate.",
+			Type:               config.ParameterType_String,
+			Default:            map[config.Network]interface{}{config.Network_All: ""},
+			AffectsContainers:  []config.ContainerID{config.ContainerID_Api, config.ContainerID_Node, config.ContainerID_Watchtower},
+			CanBeBlank:         true,
+			OverwriteOnUpgrade: false,
+		},
+
+		BeaconTlsKeyPath: config.Parameter{
+			ID:                 "beaconTlsKeyPath",
+			Name:               "Beacon TLS Client Key",
+			Description:        "If your primary Beacon node's API requires mutual TLS, enter the path of the client certificate's private key (in PEM format) here. The path must be accessible to the Smartnode's daemon.\n\nLeave this blank if your Beacon node doesn't require a client certificate.",
+			Type:               config.ParameterType_String,
+			Default:            map[config.Network]interface{}{config.Network_All: ""},
+			AffectsContainers:  []config.ContainerID{config.ContainerID_Api, config.ContainerID_Node, config.ContainerID_Watchtower},
+			CanBeBlank:         true,
+			OverwriteOnUpgrade: false,
+		},
+
+		FallbackBeaconApiToken: config.Parameter{
+			ID:                 "fallbackBeaconApiToken",
+			Name:               "Fallback Beacon API Token",
+			Description:        "If your fallback Beacon node's API is behind a reverse proxy that requires authentication, enter the bearer token here. It will only be sent with the requests the Smartnode makes to your fallback Beacon node, so your primary node's credentials are never sent to it.\n\nLeave this blank if your fallback Beacon node doesn't require authentication.",
+			Type:               config.ParameterType_String,
+			Default:            map[config.Network]interface{}{config.Network_All: ""},
+			AffectsContainers:  []config.ContainerID{config.ContainerID_Api, config.ContainerID_Node, config.ContainerID_Watchtower},
+			CanBeBlank:         true,
+			OverwriteOnUpgrade: false,
+		},
+
+		FallbackBeaconTlsCertPath: config.Parameter{
+			ID:                 "fallbackBeaconTlsCertPath",
+			Name:               "Fallback Beacon TLS Client Certificate",
+			Description:        "If your fallback Beacon node's API requires mutual TLS, enter the path of the client certificate (in PEM format) here. The path must be accessible to the Smartnode's daemon.\n\nLeave this blank if your fallback Beacon node doesn't require a client certificate.",
+			Type:               config.ParameterType_String,
+			Default:            map[config.Network]interface{}{config.Network_All: ""},
+			AffectsContainers:  []config.ContainerID{config.ContainerID_Api, config.ContainerID_Node, config.ContainerID_Watchtower},
+			CanBeBlank:         true,
+			OverwriteOnUpgrade: false,
+		},
+
+		FallbackBeaconTlsKeyPath: config.Parameter{
+			ID:                 "fallbackBeaconTlsKeyPath",
+			Name:               "Fallback Beacon TLS Client Key",
+			Description:        "If your fallback Beacon node's API requires mutual TLS, enter the path of the client certificate's private key (in PEM format) here. The path must be accessible to the Smartnode's daemon.\n\nLeave this blank if your fallback Beacon node doesn't require a client certificate.",
+			Type:               config.ParameterType_String,
+			Default:            map[config.Network]interface{}{config.Network_All: ""},
+			AffectsContainers:  []config.ContainerID{config.ContainerID_Api, config.ContainerID_Node, config.ContainerID_Watchtower},
+			CanBeBlank:         true,
+			OverwriteOnUpgrade: false,
+		},
+
+		ObserverNodeAddress: config.Parameter{
+			ID:                 "observerNodeAddress",
+			Name:               "Observer Node Address",
+			Description:        "[orange]**For monitoring only.**[white]\n\nEnter the address of a node here to run the Smartnode in read-only observer mode. The Smartnode will report on this node instead of the one in your node wallet, and it will refuse to sign any transactions or messages.\n\nLeave this blank to use your node wallet normally.",
+			Type:               config.ParameterType_String,
+			Default:            map[config.Network]interface{}{config.Network_All: ""},
+			AffectsContainers:  []config.ContainerID{config.ContainerID_Api, config.ContainerID_Node, config.ContainerID_Watchtower},
+			CanBeBlank:         true,
+			OverwriteOnUpgrade: false,
+		},
+
 		UseRollingRecords: config.Parameter{
 			ID:                 "useRollingRecords",
 			Name:               "Use Rolling Records",
@@ -611,8 +960,32 @@ func (cfg *SmartnodeConfig) GetParameters() []*config.Parameter {
 		&cfg.RewardsTreeMode,
 		&cfg.RewardsTreeCustomUrl,
 		&cfg.ArchiveECUrl,
+		&cfg.ArchiveEcRpcHeaders,
+		&cfg.StateReadECUrl,
+		&cfg.StateReadEcRpcHeaders,
 		&cfg.WatchtowerMaxFeeOverride,
 		&cfg.WatchtowerPrioFeeOverride,
+		&cfg.WatchtowerTaskMaxFees,
+		&cfg.WatchtowerGasSources,
+		&cfg.WatchtowerMinBalance,
+		&cfg.WatchtowerDissolveBatchThreshold,
+		&cfg.WatchtowerDissolveGracePeriod,
+		&cfg.WatchtowerMaxDissolvesPerRun,
+		&cfg.EnableDebugRoutes,
+		&cfg.EcSyncConfirmations,
+		&cfg.WatchtowerDissolveExclusions,
+		&cfg.TxInclusionTimeout,
+		&cfg.WatchtowerStuckTxTimeout,
+		&cfg.WatchtowerStuckTxBumpPercent,
+		&cfg.EcRpcHeaders,
+		&cfg.FallbackEcRpcHeaders,
+		&cfg.BeaconApiToken,
+		&cfg.BeaconTlsCertPath,
+		&cfg.BeaconTlsKeyPath,
+		&cfg.FallbackBeaconApiToken,
+		&cfg.FallbackBeaconTlsCertPath,
+		&cfg.FallbackBeaconTlsKeyPath,
+		&cfg.ObserverNodeAddress,
 		&cfg.UseRollingRecords,
 		&cfg.RecordCheckpointInterval,
 		&cfg.CheckpointRetentionLimit,
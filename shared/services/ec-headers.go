@@ -0,0 +1,140 @@
+package services
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/rocket-pool/smartnode/shared/services/config"
+)
+
+// An HTTP transport that adds a set of custom headers to every request
+type headerTransport struct {
+	headers http.Header
+	base    http.RoundTripper
+}
+
+// Send the request with the custom headers added
+func (t *headerTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	// Round trippers must not modify the original request
+	req = req.Clone(req.Context())
+	for name, values := range t.headers {
+		req.Header[name] = values
+	}
+	return t.base.RoundTrip(req)
+}
+
+// Parse a comma-separated list of Name=Value pairs into a set of HTTP headers
+func parseEcRpcHeaders(value string) (http.Header, error) {
+	headers := http.Header{}
+	for i, element := range strings.Split(value, ",") {
+		element = strings.TrimSpace(element)
+		if element == "" {
+			continue
+		}
+		// The element isn't included in the error since it may be a secret
+		name, headerValue, found := strings.Cut(element, "=")
+		name = strings.TrimSpace(name)
+		if !found || name == "" {
+			return nil, fmt.Errorf("Invalid Execution client header %d; headers must be in the form Name=Value", i+1)
+		}
+		headers.Add(name, strings.TrimSpace(headerValue))
+	}
+	return headers, nil
+}
+
+// Describe a set of headers with their values redacted, so they can be logged without leaking API keys
+func redactHeaders(headers http.Header) string {
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name+"=<redacted>")
+	}
+	sort.Strings(names)
+	return strings.Join(names, ", ")
+}
+
+// Connect to an Execution client, sending the provided headers with every request
+func dialExecutionClient(ecUrl string, headers http.Header) (*ethclient.Client, error) {
+	if len(headers) == 0 {
+		return ethclient.Dial(ecUrl)
+	}
+
+	// Headers can only be added to HTTP connections
+	parsedUrl, err := url.Parse(ecUrl)
+	if err != nil {
+		return nil, err
+	}
+	if parsedUrl.Scheme != "http" && parsedUrl.Scheme != "https" {
+		return nil, fmt.Errorf("custom headers [%s] can only be sent to HTTP Execution clients", redactHeaders(headers))
+	}
+
+	client, err := rpc.DialHTTPWithClient(ecUrl, &http.Client{
+		Transport: &headerTransport{
+			headers: headers,
+			base:    http.DefaultTransport,
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error connecting with custom headers [%s]: %w", redactHeaders(headers), err)
+	}
+	return ethclient.NewClient(client), nil
+}
+
+// Connect to the primary Execution client and the fallback one, if there is one.
+// Each client only gets its own custom headers, so one provider's API key is never sent to the other.
+func dialExecutionClients(cfg *config.SmartnodeConfig, primaryEcUrl string, fallbackEcUrl string) (*ethclient.Client, *ethclient.Client, error) {
+	primaryHeaders, err := parseEcRpcHeaders(cfg.EcRpcHeaders.Value.(string))
+	if err != nil {
+		return nil, nil, fmt.Errorf("error parsing the primary Execution client headers: %w", err)
+	}
+	primaryEc, err := dialExecutionClient(primaryEcUrl, primaryHeaders)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error connecting to primary EC at [%s]: %w", primaryEcUrl, err)
+	}
+	if fallbackEcUrl == "" {
+		return primaryEc, nil, nil
+	}
+
+	fallbackHeaders, err := parseEcRpcHeaders(cfg.FallbackEcRpcHeaders.Value.(string))
+	if err != nil {
+		primaryEc.Close()
+		return nil, nil, fmt.Errorf("error parsing the fallback Execution client headers: %w", err)
+	}
+	fallbackEc, err := dialExecutionClient(fallbackEcUrl, fallbackHeaders)
+	if err != nil {
+		primaryEc.Close()
+		return nil, nil, fmt.Errorf("error connecting to fallback EC at [%s]: %w", fallbackEcUrl, err)
+	}
+	return primaryEc, fallbackEc, nil
+}
+
+// Connect to the state read EC, using its own custom headers
+func dialStateReadClient(cfg *config.SmartnodeConfig, stateReadEcUrl string) (*ethclient.Client, error) {
+	headers, err := parseEcRpcHeaders(cfg.StateReadEcRpcHeaders.Value.(string))
+	if err != nil {
+		return nil, fmt.Errorf("error parsing the state read EC headers: %w", err)
+	}
+	ec, err := dialExecutionClient(stateReadEcUrl, headers)
+	if err != nil {
+		return nil, fmt.Errorf("error connecting to state read EC at [%s]: %w", stateReadEcUrl, err)
+	}
+	return ec, nil
+}
+
+// Connect to the archive EC, using its own custom headers
+func DialArchiveClient(cfg *config.SmartnodeConfig, archiveEcUrl string) (*ethclient.Client, error) {
+	headers, err := parseEcRpcHeaders(cfg.ArchiveEcRpcHeaders.Value.(string))
+	if err != nil {
+		return nil, fmt.Errorf("error parsing the archive EC headers: %w", err)
+	}
+	ec, err := dialExecutionClient(archiveEcUrl, headers)
+	if err != nil {
+		return nil, fmt.Errorf("error connecting to archive EC at [%s]: %w", archiveEcUrl, err)
+	}
+	return ec, nil
+}
@@ -0,0 +1,159 @@
+package services
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/rocket-pool/smartnode/shared/services/config"
+	cfgtypes "github.com/rocket-pool/smartnode/shared/types/config"
+)
+
+const testApiKey = "test-api-key"
+
+// Creates a test Execution client that rejects requests without the expected API key header
+func newHeaderCheckingServer() *httptest.Server {
+	return newApiKeyCheckingServer(testApiKey)
+}
+
+// Creates a test Execution client that rejects requests without the provided API key header
+func newApiKeyCheckingServer(apiKey string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Api-Key") != apiKey {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":"0x4268"}`))
+	}))
+}
+
+func TestEcRpcHeadersAreSent(t *testing.T) {
+	server := newHeaderCheckingServer()
+	defer server.Close()
+
+	headers, err := parseEcRpcHeaders("X-Api-Key=" + testApiKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client, err := dialExecutionClient(server.URL, headers)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	chainID, err := client.ChainID(context.Background())
+	if err != nil {
+		t.Fatalf("error getting chain ID: %s", err.Error())
+	}
+	if chainID.Uint64() != 17000 {
+		t.Fatalf("expected chain ID 17000 but got %s", chainID.String())
+	}
+}
+
+func TestMissingEcRpcHeadersAreRejected(t *testing.T) {
+	server := newHeaderCheckingServer()
+	defer server.Close()
+
+	client, err := dialExecutionClient(server.URL, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.ChainID(context.Background()); err == nil {
+		t.Fatal("expected the request without the API key to be rejected")
+	}
+}
+
+func TestEcRpcHeaderParsing(t *testing.T) {
+	headers, err := parseEcRpcHeaders(" X-Api-Key = abc=123 , X-Other=value,")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if headers.Get("X-Api-Key") != "abc=123" || headers.Get("X-Other") != "value" {
+		t.Fatalf("unexpected headers: %v", headers)
+	}
+
+	// Malformed headers shouldn't leak their contents in the error
+	_, err = parseEcRpcHeaders("X-Api-Key=abc,secret-without-a-name")
+	if err == nil {
+		t.Fatal("expected a header without a value to be rejected")
+	}
+	if strings.Contains(err.Error(), "secret") {
+		t.Fatalf("the error leaked the header's contents: %s", err.Error())
+	}
+}
+
+func TestEcRpcHeadersAreRedacted(t *testing.T) {
+	headers, err := parseEcRpcHeaders("X-Api-Key=" + testApiKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	redacted := redactHeaders(headers)
+	if strings.Contains(redacted, testApiKey) {
+		t.Fatalf("the header value was not redacted: %s", redacted)
+	}
+	if redacted != "X-Api-Key=<redacted>" {
+		t.Fatalf("unexpected redacted headers: %s", redacted)
+	}
+
+	// Connection errors shouldn't include the header values either
+	_, err = dialExecutionClient("ws://localhost:8546", headers)
+	if err == nil {
+		t.Fatal("expected headers on a websocket connection to be rejected")
+	}
+	if strings.Contains(err.Error(), testApiKey) {
+		t.Fatalf("the error leaked the header value: %s", err.Error())
+	}
+}
+
+func TestPrimaryAndFallbackEcHeadersAreSeparate(t *testing.T) {
+	primaryServer := newApiKeyCheckingServer("primary-key")
+	defer primaryServer.Close()
+	fallbackServer := newApiKeyCheckingServer("fallback-key")
+	defer fallbackServer.Close()
+
+	cfg := &config.SmartnodeConfig{
+		EcRpcHeaders:         cfgtypes.Parameter{Value: "X-Api-Key=primary-key"},
+		FallbackEcRpcHeaders: cfgtypes.Parameter{Value: "X-Api-Key=fallback-key"},
+	}
+	primaryEc, fallbackEc, err := dialExecutionClients(cfg, primaryServer.URL, fallbackServer.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := primaryEc.ChainID(context.Background()); err != nil {
+		t.Fatalf("the primary EC didn't get its own headers: %s", err.Error())
+	}
+	if _, err := fallbackEc.ChainID(context.Background()); err != nil {
+		t.Fatalf("the fallback EC didn't get its own headers: %s", err.Error())
+	}
+
+	// Each client's headers must not be sent to the other
+	swappedPrimary, swappedFallback, err := dialExecutionClients(cfg, fallbackServer.URL, primaryServer.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := swappedPrimary.ChainID(context.Background()); err == nil {
+		t.Fatal("the primary EC's headers were accepted by the fallback provider")
+	}
+	if _, err := swappedFallback.ChainID(context.Background()); err == nil {
+		t.Fatal("the fallback EC's headers were accepted by the primary provider")
+	}
+}
+
+func TestArchiveEcHeadersAreSent(t *testing.T) {
+	server := newApiKeyCheckingServer("archive-key")
+	defer server.Close()
+
+	cfg := &config.SmartnodeConfig{
+		EcRpcHeaders:        cfgtypes.Parameter{Value: "X-Api-Key=primary-key"},
+		ArchiveEcRpcHeaders: cfgtypes.Parameter{Value: "X-Api-Key=archive-key"},
+	}
+	archiveEc, err := DialArchiveClient(cfg, server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := archiveEc.ChainID(context.Background()); err != nil {
+		t.Fatalf("the archive EC didn't get its own headers: %s", err.Error())
+	}
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/fatih/color"
+	"github.com/rocket-pool/rocketpool-go/rocketpool"
 	"github.com/rocket-pool/smartnode/shared/services/config"
 	"github.com/rocket-pool/smartnode/shared/types/api"
 	cfgtypes "github.com/rocket-pool/smartnode/shared/types/config"
@@ -64,17 +65,9 @@ func NewExecutionClientManager(cfg *config.RocketPoolConfig) (*ExecutionClientMa
 		}
 	}
 
-	primaryEc, err := ethclient.Dial(primaryEcUrl)
+	primaryEc, fallbackEc, err := dialExecutionClients(cfg.Smartnode, primaryEcUrl, fallbackEcUrl)
 	if err != nil {
-		return nil, fmt.Errorf("error connecting to primary EC at [%s]: %w", primaryEcUrl, err)
-	}
-
-	var fallbackEc *ethclient.Client
-	if fallbackEcUrl != "" {
-		fallbackEc, err = ethclient.Dial(fallbackEcUrl)
-		if err != nil {
-			return nil, fmt.Errorf("error connecting to fallback EC at [%s]: %w", fallbackEcUrl, err)
-		}
+		return nil, err
 	}
 
 	return &ExecutionClientManager{
@@ -342,9 +335,11 @@ func (p *ExecutionClientManager) CheckStatus(cfg *config.RocketPoolConfig) *api.
 	if p.ignoreSyncCheck {
 		status.PrimaryClientStatus.IsWorking = p.primaryReady
 		status.PrimaryClientStatus.IsSynced = p.primaryReady
+		status.PrimaryClientStatus.SyncStatus = classifyEcSyncStatus(status.PrimaryClientStatus)
 		if status.FallbackEnabled {
 			status.FallbackClientStatus.IsWorking = p.fallbackReady
 			status.FallbackClientStatus.IsSynced = p.fallbackReady
+			status.FallbackClientStatus.SyncStatus = classifyEcSyncStatus(status.FallbackClientStatus)
 		}
 		return status
 	}
@@ -374,6 +369,29 @@ func (p *ExecutionClientManager) CheckStatus(cfg *config.RocketPoolConfig) *api.
 	return status
 }
 
+// Check if the primary client was working and synced during the last status check
+func (p *ExecutionClientManager) IsPrimaryReady() bool {
+	return p.primaryReady
+}
+
+// Check if the fallback client was working and synced during the last status check
+func (p *ExecutionClientManager) IsFallbackReady() bool {
+	return p.fallbackReady
+}
+
+// Get the primary client
+func (p *ExecutionClientManager) GetPrimaryClient() rocketpool.ExecutionClient {
+	return p.primaryEc
+}
+
+// Get the fallback client, or nil if there isn't one
+func (p *ExecutionClientManager) GetFallbackClient() rocketpool.ExecutionClient {
+	if p.fallbackEc == nil {
+		return nil
+	}
+	return p.fallbackEc
+}
+
 func getNetworkNameFromId(networkId uint) string {
 	switch networkId {
 	case 1:
@@ -388,6 +406,28 @@ func getNetworkNameFromId(networkId uint) string {
 
 // Check the client status
 func checkEcStatus(client *ethclient.Client) api.ClientStatus {
+	status := getEcStatus(client)
+	status.SyncStatus = classifyEcSyncStatus(status)
+	return status
+}
+
+// Classify an EC as offline, syncing, pruning, or ready from its status report.
+// Clients that are pruning their state reject sync checks with an error that mentions it, so they'd otherwise look offline.
+func classifyEcSyncStatus(status api.ClientStatus) api.EcSyncStatus {
+	if status.IsWorking && status.IsSynced {
+		return api.EcSyncStatus_Ready
+	}
+	if status.IsWorking {
+		return api.EcSyncStatus_Syncing
+	}
+	if strings.Contains(strings.ToLower(status.Error), "prun") {
+		return api.EcSyncStatus_Pruning
+	}
+	return api.EcSyncStatus_Offline
+}
+
+// Get the status report of an EC
+func getEcStatus(client *ethclient.Client) api.ClientStatus {
 
 	status := api.ClientStatus{}
 
@@ -0,0 +1,66 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/rocket-pool/smartnode/shared/types/api"
+)
+
+func TestEcSyncStatusClassification(t *testing.T) {
+	tests := []struct {
+		name     string
+		status   api.ClientStatus
+		expected api.EcSyncStatus
+	}{
+		{
+			name:     "synced client",
+			status:   api.ClientStatus{IsWorking: true, IsSynced: true, SyncProgress: 1},
+			expected: api.EcSyncStatus_Ready,
+		},
+		{
+			name:     "syncing client",
+			status:   api.ClientStatus{IsWorking: true, SyncProgress: 0.42},
+			expected: api.EcSyncStatus_Syncing,
+		},
+		{
+			name:     "client without enough peers",
+			status:   api.ClientStatus{IsWorking: true, Error: "Client claims to have finished syncing, but its last block was from 1h0m0s ago. It likely doesn't have enough peers"},
+			expected: api.EcSyncStatus_Syncing,
+		},
+		{
+			name:     "pruning client",
+			status:   api.ClientStatus{Error: "Sync progress check failed with [state pruning in progress]"},
+			expected: api.EcSyncStatus_Pruning,
+		},
+		{
+			name:     "pruning client with capitalized error",
+			status:   api.ClientStatus{Error: "Sync progress check failed with [Pruning is running, try again later]"},
+			expected: api.EcSyncStatus_Pruning,
+		},
+		{
+			name:     "offline client",
+			status:   api.ClientStatus{Error: "Sync progress check failed with [dial tcp 127.0.0.1:8545: connect: connection refused]"},
+			expected: api.EcSyncStatus_Offline,
+		},
+	}
+
+	for _, test := range tests {
+		if status := classifyEcSyncStatus(test.status); status != test.expected {
+			t.Errorf("%s: expected %s but got %s", test.name, test.expected, status)
+		}
+	}
+}
+
+func TestPruningEcIsDescribedAsPruning(t *testing.T) {
+	status := api.ClientStatus{Error: "state pruning in progress"}
+	status.SyncStatus = classifyEcSyncStatus(status)
+	if description := describeUnavailableEc(status); description != "pruning its state (state pruning in progress)" {
+		t.Fatalf("unexpected description for a pruning client: %s", description)
+	}
+
+	status = api.ClientStatus{Error: "connection refused"}
+	status.SyncStatus = classifyEcSyncStatus(status)
+	if description := describeUnavailableEc(status); description != "unavailable (connection refused)" {
+		t.Fatalf("unexpected description for an offline client: %s", description)
+	}
+}
@@ -0,0 +1,86 @@
+package services
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/urfave/cli"
+
+	"github.com/rocket-pool/smartnode/shared/services/beacon"
+	cfgtypes "github.com/rocket-pool/smartnode/shared/types/config"
+)
+
+// A fork that the Beacon client is expected to have scheduled
+type expectedFork struct {
+	name    string
+	version []byte
+	epoch   uint64
+}
+
+// The forks each network is expected to have, in order
+var expectedForkSchedules = map[cfgtypes.Network][]expectedFork{
+	cfgtypes.Network_Mainnet: {
+		{name: "Altair", version: []byte{0x01, 0x00, 0x00, 0x00}, epoch: 74240},
+		{name: "Bellatrix", version: []byte{0x02, 0x00, 0x00, 0x00}, epoch: 144896},
+		{name: "Capella", version: []byte{0x03, 0x00, 0x00, 0x00}, epoch: 194048},
+		{name: "Deneb", version: []byte{0x04, 0x00, 0x00, 0x00}, epoch: 269568},
+	},
+	cfgtypes.Network_Holesky: {
+		{name: "Altair", version: []byte{0x02, 0x01, 0x70, 0x00}, epoch: 0},
+		{name: "Bellatrix", version: []byte{0x03, 0x01, 0x70, 0x00}, epoch: 0},
+		{name: "Capella", version: []byte{0x04, 0x01, 0x70, 0x00}, epoch: 256},
+		{name: "Deneb", version: []byte{0x05, 0x01, 0x70, 0x00}, epoch: 29696},
+	},
+}
+
+// Check that the Beacon client's fork schedule matches the one expected for the configured network.
+// Returns an error describing every mismatch, such as a client that hasn't been upgraded for an upcoming fork.
+// Networks without a known schedule (e.g. devnets) are not checked.
+func CheckForkSchedule(c *cli.Context) error {
+	cfg, err := GetConfig(c)
+	if err != nil {
+		return err
+	}
+	expectedForks, exists := expectedForkSchedules[cfg.Smartnode.Network.Value.(cfgtypes.Network)]
+	if !exists {
+		return nil
+	}
+	bc, err := GetBeaconClient(c)
+	if err != nil {
+		return err
+	}
+	forks, err := bc.GetForkSchedule()
+	if err != nil {
+		return fmt.Errorf("error getting the Beacon client's fork schedule: %w", err)
+	}
+
+	mismatches := getForkScheduleMismatches(expectedForks, forks)
+	if len(mismatches) > 0 {
+		return fmt.Errorf("the Beacon client's fork schedule does not match the expected one for the %s network:\n%s", cfg.Smartnode.Network.Value.(cfgtypes.Network), strings.Join(mismatches, "\n"))
+	}
+	return nil
+}
+
+// Describe every expected fork that is missing from the client's schedule or scheduled at the wrong epoch
+func getForkScheduleMismatches(expectedForks []expectedFork, forks []beacon.ForkInfo) []string {
+	mismatches := []string{}
+	for _, expected := range expectedForks {
+		found := false
+		for _, fork := range forks {
+			if !bytes.Equal(fork.CurrentVersion, expected.version) {
+				continue
+			}
+			found = true
+			if fork.Epoch != expected.epoch {
+				mismatches = append(mismatches, fmt.Sprintf("%s (version 0x%s) is scheduled for epoch %d instead of %d", expected.name, hex.EncodeToString(expected.version), fork.Epoch, expected.epoch))
+			}
+			break
+		}
+		if !found {
+			mismatches = append(mismatches, fmt.Sprintf("%s (version 0x%s, epoch %d) is not scheduled; the client may need to be upgraded", expected.name, hex.EncodeToString(expected.version), expected.epoch))
+		}
+	}
+	return mismatches
+}
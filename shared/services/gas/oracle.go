@@ -0,0 +1,78 @@
+package gas
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/rocket-pool/rocketpool-go/rocketpool"
+)
+
+// The number of recent blocks whose base fees are considered
+const recentBaseFeeBlocks int64 = 5
+
+// A gas fee recommendation blended from several sources, along with the values from each source
+type GasRecommendation struct {
+	MaxFeeWei      *big.Int
+	PriorityFeeWei *big.Int
+
+	// Component values
+	SuggestedTipWei *big.Int // The EC's suggested priority fee
+	BaseFeeWei      *big.Int // The highest base fee of the recent blocks
+	OracleFeeWei    *big.Int // The external oracle's rapid gas price, nil if it wasn't used or couldn't be reached
+	OracleErr       error    // The reason the external oracle couldn't be used
+}
+
+// Get a recommended max fee and priority fee from the EC's suggested priority fee and the recent base fees, and optionally an
+// external gas oracle. The max fee leaves room for the base fee to double before the transaction is included; if the oracle's
+// rapid gas price is higher, it is used instead.
+func GetBlendedGasRecommendation(ec rocketpool.ExecutionClient, useOracle bool) (GasRecommendation, error) {
+	recommendation := GasRecommendation{}
+
+	// Get the suggested priority fee
+	tip, err := ec.SuggestGasTipCap(context.Background())
+	if err != nil {
+		return recommendation, fmt.Errorf("error getting the suggested priority fee: %w", err)
+	}
+	recommendation.SuggestedTipWei = tip
+
+	// Get the highest of the recent base fees
+	latestHeader, err := ec.HeaderByNumber(context.Background(), nil)
+	if err != nil {
+		return recommendation, fmt.Errorf("error getting the latest block: %w", err)
+	}
+	if latestHeader.BaseFee == nil {
+		return recommendation, fmt.Errorf("the latest block does not have a base fee")
+	}
+	baseFee := big.NewInt(0).Set(latestHeader.BaseFee)
+	for i := int64(1); i < recentBaseFeeBlocks && latestHeader.Number.Int64() >= i; i++ {
+		header, err := ec.HeaderByNumber(context.Background(), big.NewInt(0).Sub(latestHeader.Number, big.NewInt(i)))
+		if err != nil {
+			return recommendation, fmt.Errorf("error getting block %d: %w", latestHeader.Number.Int64()-i, err)
+		}
+		if header.BaseFee != nil && header.BaseFee.Cmp(baseFee) > 0 {
+			baseFee.Set(header.BaseFee)
+		}
+	}
+	recommendation.BaseFeeWei = baseFee
+
+	// Blend them into the max fee
+	recommendation.PriorityFeeWei = big.NewInt(0).Set(tip)
+	recommendation.MaxFeeWei = big.NewInt(0).Mul(baseFee, big.NewInt(2))
+	recommendation.MaxFeeWei.Add(recommendation.MaxFeeWei, tip)
+
+	// Use the oracle's price if it's higher
+	if useOracle {
+		oracleFee, err := GetHeadlessMaxFeeWei()
+		if err != nil {
+			recommendation.OracleErr = err
+		} else {
+			recommendation.OracleFeeWei = oracleFee
+			if oracleFee.Cmp(recommendation.MaxFeeWei) > 0 {
+				recommendation.MaxFeeWei.Set(oracleFee)
+			}
+		}
+	}
+
+	return recommendation, nil
+}
@@ -8,18 +8,21 @@ import (
 	"sync"
 	"time"
 
+	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/rocket-pool/rocketpool-go/dao/trustednode"
 	"github.com/rocket-pool/rocketpool-go/node"
 	"github.com/rocket-pool/rocketpool-go/rocketpool"
 	"github.com/rocket-pool/smartnode/shared/services/alerting"
 	"github.com/rocket-pool/smartnode/shared/services/config"
+	"github.com/rocket-pool/smartnode/shared/types/api"
 	"github.com/urfave/cli"
 )
 
 // Settings
 const EthClientSyncTimeout = 16    // 16 seconds
 const BeaconClientSyncTimeout = 16 // 16 seconds
+const ecSyncHistorySize = 32       // 32 samples
 var checkNodePasswordInterval, _ = time.ParseDuration("15s")
 var checkNodeWalletInterval, _ = time.ParseDuration("15s")
 var checkRocketStorageInterval, _ = time.ParseDuration("15s")
@@ -45,6 +48,14 @@ func RequireNodePassword(c *cli.Context) error {
 }
 
 func RequireNodeWallet(c *cli.Context) error {
+	observer, err := getObserverMode(c)
+	if err != nil {
+		return err
+	}
+	if observer {
+		// Read-only routes can use the observed node's address without a wallet; signing is rejected by the wallet itself
+		return nil
+	}
 	if err := RequireNodePassword(c); err != nil {
 		return err
 	}
@@ -142,6 +153,34 @@ func RequireNodeTrusted(c *cli.Context) error {
 	return nil
 }
 
+func RequireInSmoothingPool(c *cli.Context) error {
+	if err := RequireNodeRegistered(c); err != nil {
+		return err
+	}
+	inSmoothingPool, err := getNodeInSmoothingPool(c)
+	if err != nil {
+		return err
+	}
+	if !inSmoothingPool {
+		return errors.New("The node is not opted into the Smoothing Pool. Please run 'rocketpool node join-smoothing-pool' and try again.")
+	}
+	return nil
+}
+
+func RequireFeeDistributorInitialized(c *cli.Context) error {
+	if err := RequireNodeRegistered(c); err != nil {
+		return err
+	}
+	feeDistributorInitialized, err := getNodeFeeDistributorInitialized(c)
+	if err != nil {
+		return err
+	}
+	if !feeDistributorInitialized {
+		return errors.New("The node's fee distributor has not been initialized yet. Please run 'rocketpool node initialize-fee-distributor' and try again.")
+	}
+	return nil
+}
+
 //
 // Service synchronization
 //
@@ -186,6 +225,13 @@ func WaitEthClientSynced(c *cli.Context, verbose bool) error {
 	return err
 }
 
+// Wait for the eth client to sync, logging its progress and reporting how long it took, how many times its sync progress was polled,
+// and the last sync percentage it reported
+func WaitEthClientSyncedVerbose(c *cli.Context) (WaitEthClientSyncedResult, error) {
+	_, result, err := waitEthClientSyncedWithResult(c, true, 0)
+	return result, err
+}
+
 func WaitBeaconClientSynced(c *cli.Context, verbose bool) error {
 	_, err := waitBeaconClientSynced(c, verbose, 0)
 	return err
@@ -245,6 +291,15 @@ func getNodePasswordSet(c *cli.Context) (bool, error) {
 	return pm.IsPasswordSet(), nil
 }
 
+// Check if the node wallet is observing a node address from the config
+func getObserverMode(c *cli.Context) (bool, error) {
+	w, err := GetWallet(c)
+	if err != nil {
+		return false, err
+	}
+	return w.IsObserver(), nil
+}
+
 // Check if the node wallet is initialized
 func getNodeWalletInitialized(c *cli.Context) (bool, error) {
 	w, err := GetWallet(c)
@@ -254,6 +309,11 @@ func getNodeWalletInitialized(c *cli.Context) (bool, error) {
 	return w.GetInitialized()
 }
 
+// Check if the RocketStorage contract has been deployed at the configured address, without requiring it
+func IsRocketStorageDeployed(c *cli.Context) (bool, error) {
+	return getRocketStorageLoaded(c)
+}
+
 // Check if the RocketStorage contract is loaded
 func getRocketStorageLoaded(c *cli.Context) (bool, error) {
 	cfg, err := GetConfig(c)
@@ -322,22 +382,69 @@ func getNodeTrusted(c *cli.Context) (bool, error) {
 	return trustednode.GetMemberExists(rp, nodeAccount.Address, nil)
 }
 
+// Check if the node is opted into the smoothing pool
+func getNodeInSmoothingPool(c *cli.Context) (bool, error) {
+	w, err := GetWallet(c)
+	if err != nil {
+		return false, err
+	}
+	rp, err := GetRocketPool(c)
+	if err != nil {
+		return false, err
+	}
+	nodeAccount, err := w.GetNodeAccount()
+	if err != nil {
+		return false, err
+	}
+	return node.GetSmoothingPoolRegistrationState(rp, nodeAccount.Address, nil)
+}
+
+// Check if the node's fee distributor has been initialized
+func getNodeFeeDistributorInitialized(c *cli.Context) (bool, error) {
+	w, err := GetWallet(c)
+	if err != nil {
+		return false, err
+	}
+	rp, err := GetRocketPool(c)
+	if err != nil {
+		return false, err
+	}
+	nodeAccount, err := w.GetNodeAccount()
+	if err != nil {
+		return false, err
+	}
+	return node.GetFeeDistributorInitialized(rp, nodeAccount.Address, nil)
+}
+
 // Wait for the eth client to sync
 // timeout of 0 indicates no timeout
 var ethClientSyncLock sync.Mutex
 
-func checkExecutionClientStatus(ecMgr *ExecutionClientManager, cfg *config.RocketPoolConfig) (bool, rocketpool.ExecutionClient, error) {
+// The recent sync progress of the EC, recorded while waiting for it to sync
+var ecSyncHistory syncProgressHistory
+
+// The parts of the execution client manager that are used to wait for it to sync
+type executionClientSyncChecker interface {
+	CheckStatus(cfg *config.RocketPoolConfig) *api.ClientManagerStatus
+	SyncProgress(ctx context.Context) (*ethereum.SyncProgress, error)
+	IsPrimaryReady() bool
+	IsFallbackReady() bool
+	GetPrimaryClient() rocketpool.ExecutionClient
+	GetFallbackClient() rocketpool.ExecutionClient
+}
+
+func checkExecutionClientStatus(ecMgr executionClientSyncChecker, cfg *config.RocketPoolConfig) (bool, rocketpool.ExecutionClient, error) {
 
 	// Check the EC status
 	mgrStatus := ecMgr.CheckStatus(cfg)
-	if ecMgr.primaryReady {
+	if ecMgr.IsPrimaryReady() {
 		return true, nil, nil
 	}
 
 	// If the primary isn't synced but there's a fallback and it is, return true
-	if ecMgr.fallbackReady {
+	if ecMgr.IsFallbackReady() {
 		if mgrStatus.PrimaryClientStatus.Error != "" {
-			log.Printf("Primary execution client is unavailable (%s), using fallback execution client...\n", mgrStatus.PrimaryClientStatus.Error)
+			log.Printf("Primary execution client is %s, using fallback execution client...\n", describeUnavailableEc(mgrStatus.PrimaryClientStatus))
 		} else {
 			log.Printf("Primary execution client is still syncing (%.2f%%), using fallback execution client...\n", mgrStatus.PrimaryClientStatus.SyncProgress*100)
 		}
@@ -349,21 +456,29 @@ func checkExecutionClientStatus(ecMgr *ExecutionClientManager, cfg *config.Rocke
 	// Is the primary working and syncing? If so, wait for it
 	if mgrStatus.PrimaryClientStatus.IsWorking && mgrStatus.PrimaryClientStatus.Error == "" {
 		log.Printf("Fallback execution client is not configured or unavailable, waiting for primary execution client to finish syncing (%.2f%%)\n", mgrStatus.PrimaryClientStatus.SyncProgress*100)
-		return false, ecMgr.primaryEc, nil
+		return false, ecMgr.GetPrimaryClient(), nil
 	}
 
 	// Is the fallback working and syncing? If so, wait for it
 	if mgrStatus.FallbackEnabled && mgrStatus.FallbackClientStatus.IsWorking && mgrStatus.FallbackClientStatus.Error == "" {
-		log.Printf("Primary execution client is unavailable (%s), waiting for the fallback execution client to finish syncing (%.2f%%)\n", mgrStatus.PrimaryClientStatus.Error, mgrStatus.FallbackClientStatus.SyncProgress*100)
-		return false, ecMgr.fallbackEc, nil
+		log.Printf("Primary execution client is %s, waiting for the fallback execution client to finish syncing (%.2f%%)\n", describeUnavailableEc(mgrStatus.PrimaryClientStatus), mgrStatus.FallbackClientStatus.SyncProgress*100)
+		return false, ecMgr.GetFallbackClient(), nil
 	}
 
 	// If neither client is working, report the errors
 	if mgrStatus.FallbackEnabled {
-		return false, nil, fmt.Errorf("Primary execution client is unavailable (%s) and fallback execution client is unavailable (%s), no execution clients are ready.", mgrStatus.PrimaryClientStatus.Error, mgrStatus.FallbackClientStatus.Error)
+		return false, nil, fmt.Errorf("Primary execution client is %s and fallback execution client is %s, no execution clients are ready.", describeUnavailableEc(mgrStatus.PrimaryClientStatus), describeUnavailableEc(mgrStatus.FallbackClientStatus))
 	}
 
-	return false, nil, fmt.Errorf("Primary execution client is unavailable (%s) and no fallback execution client is configured.", mgrStatus.PrimaryClientStatus.Error)
+	return false, nil, fmt.Errorf("Primary execution client is %s and no fallback execution client is configured.", describeUnavailableEc(mgrStatus.PrimaryClientStatus))
+}
+
+// Describe why an execution client can't be used, so operators can tell a pruning client that will come back on its own from one that's offline
+func describeUnavailableEc(status api.ClientStatus) string {
+	if status.SyncStatus == api.EcSyncStatus_Pruning {
+		return fmt.Sprintf("pruning its state (%s)", status.Error)
+	}
+	return fmt.Sprintf("unavailable (%s)", status.Error)
 }
 
 func checkBeaconClientStatus(bcMgr *BeaconClientManager) (bool, error) {
@@ -407,6 +522,12 @@ func checkBeaconClientStatus(bcMgr *BeaconClientManager) (bool, error) {
 }
 
 func waitEthClientSynced(c *cli.Context, verbose bool, timeout int64) (bool, error) {
+	synced, _, err := waitEthClientSyncedWithResult(c, verbose, timeout)
+	return synced, err
+}
+
+// Wait for the eth client to sync, reporting how long it took
+func waitEthClientSyncedWithResult(c *cli.Context, verbose bool, timeout int64) (bool, WaitEthClientSyncedResult, error) {
 
 	// Prevent multiple waiting goroutines from requesting sync progress
 	ethClientSyncLock.Lock()
@@ -415,24 +536,56 @@ func waitEthClientSynced(c *cli.Context, verbose bool, timeout int64) (bool, err
 	// Get eth client
 	ecMgr, err := GetEthClient(c)
 	if err != nil {
-		return false, err
+		return false, WaitEthClientSyncedResult{}, err
 	}
 
 	cfg, err := GetConfig(c)
 	if err != nil {
-		return false, err
+		return false, WaitEthClientSyncedResult{}, err
+	}
+
+	return waitForExecutionClientSyncWithResult(ecMgr, cfg, verbose, timeout)
+
+}
+
+// Wait until either of the manager's clients is synced, or the timeout has passed
+func waitForExecutionClientSync(ecMgr executionClientSyncChecker, cfg *config.RocketPoolConfig, verbose bool, timeout int64) (bool, error) {
+	synced, _, err := waitForExecutionClientSyncWithResult(ecMgr, cfg, verbose, timeout)
+	return synced, err
+}
+
+// Wait until either of the manager's clients is synced, or the timeout has passed, and report how the wait went.
+// Every way of seeing a synced client counts towards the same run of consecutive confirmations, so a client that's only
+// briefly up to date isn't considered synced.
+func waitForExecutionClientSyncWithResult(ecMgr executionClientSyncChecker, cfg *config.RocketPoolConfig, verbose bool, timeout int64) (bool, WaitEthClientSyncedResult, error) {
+
+	result := WaitEthClientSyncedResult{}
+
+	// Require several consecutive recent blocks if configured, so a briefly up-to-date client isn't considered synced
+	confirmations := syncConfirmationCounter{
+		required: cfg.Smartnode.EcSyncConfirmations.Value.(uint64),
 	}
 
 	synced, clientToCheck, err := checkExecutionClientStatus(ecMgr, cfg)
 	if err != nil {
-		return false, err
+		return false, result, err
 	}
-	if synced {
-		return true, nil
+	if synced && confirmations.record(true) {
+		result.FinalPercent = 100
+		return true, result, nil
 	}
 
 	// Get wait start time
 	startTime := time.Now()
+	finish := func() WaitEthClientSyncedResult {
+		result.WaitDuration = time.Since(startTime)
+		return result
+	}
+	confirmed := func() (bool, WaitEthClientSyncedResult, error) {
+		alerting.AlertExecutionClientSyncComplete(cfg)
+		result.FinalPercent = 100
+		return true, finish(), nil
+	}
 
 	// Get EC status refresh time
 	ecRefreshTime := startTime
@@ -442,33 +595,50 @@ func waitEthClientSynced(c *cli.Context, verbose bool, timeout int64) (bool, err
 
 		// Check timeout
 		if (timeout > 0) && (time.Since(startTime).Seconds() > float64(timeout)) {
-			return false, nil
+			return false, finish(), nil
 		}
 
-		// Check if the EC status needs to be refreshed
-		if time.Since(ecRefreshTime) > ethClientStatusRefreshInterval {
-			log.Println("Refreshing primary / fallback execution client status...")
+		// Refresh the EC status periodically, or on every poll while a ready client is being confirmed since there's no
+		// client to poll directly
+		if synced || time.Since(ecRefreshTime) > ethClientStatusRefreshInterval {
+			if !synced {
+				log.Println("Refreshing primary / fallback execution client status...")
+			}
 			ecRefreshTime = time.Now()
 			synced, clientToCheck, err = checkExecutionClientStatus(ecMgr, cfg)
 			if err != nil {
-				return false, err
+				return false, finish(), err
 			}
 			if synced {
-				alerting.AlertExecutionClientSyncComplete(cfg)
-				return true, nil
+				result.PollCount++
+				if confirmations.record(true) {
+					return confirmed()
+				}
+				time.Sleep(ethClientSyncPollInterval)
+				continue
 			}
+			confirmations.record(false)
 		}
 
 		// Get sync progress
 		progress, err := clientToCheck.SyncProgress(context.Background())
+		result.PollCount++
 		if err != nil {
-			return false, err
+			return false, finish(), err
 		}
 
 		// Check sync progress
 		if progress != nil {
+			confirmations.record(false)
+			p := float64(progress.CurrentBlock-progress.StartingBlock) / float64(progress.HighestBlock-progress.StartingBlock)
+			if p >= 0 && p <= 1 {
+				result.FinalPercent = p * 100
+				ecSyncHistory.record(SyncProgressSample{
+					Time:     time.Now(),
+					Progress: p * 100,
+				})
+			}
 			if verbose {
-				p := float64(progress.CurrentBlock-progress.StartingBlock) / float64(progress.HighestBlock-progress.StartingBlock)
 				if p > 1 {
 					log.Println("Eth 1.0 node syncing...")
 				} else {
@@ -480,12 +650,11 @@ func waitEthClientSynced(c *cli.Context, verbose bool, timeout int64) (bool, err
 			// Get the latest block it knows about and make sure it's recent compared to system clock time
 			isUpToDate, _, err := IsSyncWithinThreshold(clientToCheck)
 			if err != nil {
-				return false, err
+				return false, finish(), err
 			}
-			// Only return true if the last reportedly known block is within our defined threshold
-			if isUpToDate {
-				alerting.AlertExecutionClientSyncComplete(cfg)
-				return true, nil
+			// Only return true if the last reportedly known block has been within our defined threshold for enough polls
+			if confirmations.record(isUpToDate) {
+				return confirmed()
 			}
 		}
 
@@ -562,7 +731,7 @@ func waitBeaconClientSynced(c *cli.Context, verbose bool, timeout int64) (bool,
 		// Check sync status
 		if syncStatus.Syncing {
 			if verbose {
-				log.Println("Eth 2.0 node syncing: %.2f%%\n", syncStatus.Progress*100)
+				log.Printf("Eth 2.0 node syncing: %.2f%%\n", syncStatus.Progress*100)
 			}
 		} else {
 			alerting.AlertBeaconClientSyncComplete(cfg)
@@ -576,6 +745,71 @@ func waitBeaconClientSynced(c *cli.Context, verbose bool, timeout int64) (bool,
 
 }
 
+// The outcome of waiting for the eth client to sync
+type WaitEthClientSyncedResult struct {
+	WaitDuration time.Duration
+	PollCount    int
+	FinalPercent float64
+}
+
+// Tracks how many consecutive sync polls have seen a recent block
+type syncConfirmationCounter struct {
+	required uint64
+	count    uint64
+}
+
+// Record the result of a poll, returning true once enough consecutive polls have seen a recent block
+func (s *syncConfirmationCounter) record(isUpToDate bool) bool {
+	if !isUpToDate {
+		s.count = 0
+		return false
+	}
+	s.count++
+	return s.count >= s.required
+}
+
+// A sync progress sample observed while waiting for the EC to sync
+type SyncProgressSample struct {
+	Time     time.Time
+	Progress float64 // Percent complete
+}
+
+// A fixed-size ring buffer of the most recent sync progress samples
+type syncProgressHistory struct {
+	lock    sync.Mutex
+	samples [ecSyncHistorySize]SyncProgressSample
+	next    int
+	count   int
+}
+
+// Record a sample, overwriting the oldest one if the buffer is full
+func (h *syncProgressHistory) record(sample SyncProgressSample) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	h.samples[h.next] = sample
+	h.next = (h.next + 1) % ecSyncHistorySize
+	if h.count < ecSyncHistorySize {
+		h.count++
+	}
+}
+
+// Get a copy of the recorded samples, from oldest to newest
+func (h *syncProgressHistory) get() []SyncProgressSample {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	samples := make([]SyncProgressSample, 0, h.count)
+	start := (h.next - h.count + ecSyncHistorySize) % ecSyncHistorySize
+	for i := 0; i < h.count; i++ {
+		samples = append(samples, h.samples[(start+i)%ecSyncHistorySize])
+	}
+	return samples
+}
+
+// Get the most recent sync progress samples of the EC, from oldest to newest; a stalled sync shows a flat history
+func GetEcSyncHistory() []SyncProgressSample {
+	return ecSyncHistory.get()
+}
+
 // Confirm the EC's latest block is within the threshold of the current system clock
 func IsSyncWithinThreshold(ec rocketpool.ExecutionClient) (bool, time.Time, error) {
 	timestamp, err := GetEthClientLatestBlockTimestamp(ec)
@@ -0,0 +1,299 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/rocket-pool/rocketpool-go/rocketpool"
+
+	"github.com/rocket-pool/smartnode/shared/services/config"
+	"github.com/rocket-pool/smartnode/shared/types/api"
+	cfgtypes "github.com/rocket-pool/smartnode/shared/types/config"
+)
+
+func TestSyncConfirmationsRequireConsecutivePolls(t *testing.T) {
+	confirmations := syncConfirmationCounter{
+		required: 3,
+	}
+
+	// Alternating in / out of threshold polls should never confirm the sync
+	for i, isUpToDate := range []bool{true, false, true, true, false, true} {
+		if confirmations.record(isUpToDate) {
+			t.Fatalf("poll %d confirmed the sync without 3 consecutive recent blocks", i)
+		}
+	}
+
+	// Two more in-threshold polls make 3 in a row
+	if confirmations.record(true) {
+		t.Fatal("the sync was confirmed after only 2 consecutive recent blocks")
+	}
+	if !confirmations.record(true) {
+		t.Fatal("the sync was not confirmed after 3 consecutive recent blocks")
+	}
+}
+
+func TestSyncHistoryKeepsLatestSamples(t *testing.T) {
+	history := syncProgressHistory{}
+	if len(history.get()) != 0 {
+		t.Fatal("a new sync history has samples")
+	}
+
+	// Overfill the buffer so the oldest samples are overwritten
+	start := time.Unix(0, 0)
+	total := ecSyncHistorySize + 5
+	for i := 0; i < total; i++ {
+		history.record(SyncProgressSample{
+			Time:     start.Add(time.Duration(i) * time.Second),
+			Progress: float64(i),
+		})
+	}
+
+	samples := history.get()
+	if len(samples) != ecSyncHistorySize {
+		t.Fatalf("expected %d samples but got %d", ecSyncHistorySize, len(samples))
+	}
+	for i, sample := range samples {
+		expected := float64(total - ecSyncHistorySize + i)
+		if sample.Progress != expected {
+			t.Fatalf("sample %d has progress %.0f but expected %.0f", i, sample.Progress, expected)
+		}
+	}
+}
+
+func TestSingleSyncConfirmationIsImmediate(t *testing.T) {
+	confirmations := syncConfirmationCounter{
+		required: 1,
+	}
+	if confirmations.record(false) {
+		t.Fatal("an out-of-threshold poll confirmed the sync")
+	}
+	if !confirmations.record(true) {
+		t.Fatal("the first in-threshold poll did not confirm the sync")
+	}
+}
+
+// A stand-in for an execution client, only compared by identity
+type fakeExecutionClient struct {
+	rocketpool.ExecutionClient
+	name string
+}
+
+// The fake client is always partway through a sync
+func (c *fakeExecutionClient) SyncProgress(ctx context.Context) (*ethereum.SyncProgress, error) {
+	return &ethereum.SyncProgress{StartingBlock: 0, CurrentBlock: 50, HighestBlock: 100}, nil
+}
+
+// Create a config that only has the sync confirmation setting, with alerts disabled
+func newTestSyncConfig(confirmations uint64) *config.RocketPoolConfig {
+	return &config.RocketPoolConfig{
+		Smartnode: &config.SmartnodeConfig{
+			EcSyncConfirmations: cfgtypes.Parameter{Value: confirmations},
+		},
+		Alertmanager: &config.AlertmanagerConfig{},
+	}
+}
+
+// An execution client manager whose status alternates between the provided ones on every check
+type flappingExecutionClientManager struct {
+	*fakeExecutionClientManager
+	statuses []api.ClientStatus
+	checks   int
+}
+
+func (m *flappingExecutionClientManager) CheckStatus(cfg *config.RocketPoolConfig) *api.ClientManagerStatus {
+	m.status.PrimaryClientStatus = m.statuses[m.checks%len(m.statuses)]
+	m.checks++
+	status := m.status
+	return &status
+}
+
+// An execution client manager that reports a fixed status
+type fakeExecutionClientManager struct {
+	status   api.ClientManagerStatus
+	primary  *fakeExecutionClient
+	fallback *fakeExecutionClient
+}
+
+func newFakeExecutionClientManager(primary api.ClientStatus, fallback *api.ClientStatus) *fakeExecutionClientManager {
+	mgr := &fakeExecutionClientManager{
+		status: api.ClientManagerStatus{
+			PrimaryClientStatus: primary,
+		},
+		primary: &fakeExecutionClient{name: "primary"},
+	}
+	if fallback != nil {
+		mgr.status.FallbackEnabled = true
+		mgr.status.FallbackClientStatus = *fallback
+		mgr.fallback = &fakeExecutionClient{name: "fallback"}
+	}
+	return mgr
+}
+
+func (m *fakeExecutionClientManager) CheckStatus(cfg *config.RocketPoolConfig) *api.ClientManagerStatus {
+	status := m.status
+	return &status
+}
+
+func (m *fakeExecutionClientManager) SyncProgress(ctx context.Context) (*ethereum.SyncProgress, error) {
+	return nil, nil
+}
+
+func (m *fakeExecutionClientManager) IsPrimaryReady() bool {
+	return m.status.PrimaryClientStatus.IsWorking && m.status.PrimaryClientStatus.IsSynced
+}
+
+func (m *fakeExecutionClientManager) IsFallbackReady() bool {
+	return m.status.FallbackEnabled && m.status.FallbackClientStatus.IsWorking && m.status.FallbackClientStatus.IsSynced
+}
+
+func (m *fakeExecutionClientManager) GetPrimaryClient() rocketpool.ExecutionClient {
+	return m.primary
+}
+
+func (m *fakeExecutionClientManager) GetFallbackClient() rocketpool.ExecutionClient {
+	if m.fallback == nil {
+		return nil
+	}
+	return m.fallback
+}
+
+var (
+	syncedClient  = api.ClientStatus{IsWorking: true, IsSynced: true, SyncProgress: 1}
+	syncingClient = api.ClientStatus{IsWorking: true, SyncProgress: 0.5}
+	downClient    = api.ClientStatus{Error: "connection refused"}
+)
+
+func TestPrimaryExecutionClientSynced(t *testing.T) {
+	mgr := newFakeExecutionClientManager(syncedClient, &downClient)
+	synced, err := waitForExecutionClientSync(mgr, newTestSyncConfig(1), false, 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if !synced {
+		t.Fatal("a synced primary client was not treated as synced")
+	}
+}
+
+func TestSyncedExecutionClientResult(t *testing.T) {
+	mgr := newFakeExecutionClientManager(syncedClient, nil)
+	synced, result, err := waitForExecutionClientSyncWithResult(mgr, newTestSyncConfig(1), false, 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if !synced {
+		t.Fatal("a synced primary client was not treated as synced")
+	}
+	if result.PollCount != 0 || result.WaitDuration != 0 {
+		t.Fatalf("an already synced client should not have been waited on, but got %+v", result)
+	}
+	if result.FinalPercent != 100 {
+		t.Fatalf("expected a final percent of 100 but got %.2f", result.FinalPercent)
+	}
+}
+
+func TestFallbackExecutionClientSynced(t *testing.T) {
+	for _, primary := range []api.ClientStatus{syncingClient, downClient} {
+		mgr := newFakeExecutionClientManager(primary, &syncedClient)
+		synced, err := waitForExecutionClientSync(mgr, newTestSyncConfig(1), false, 0)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err.Error())
+		}
+		if !synced {
+			t.Fatalf("a synced fallback client was not used when the primary client's status was %+v", primary)
+		}
+	}
+}
+
+func TestBothExecutionClientsSyncing(t *testing.T) {
+	// The primary client should be waited on while it's working
+	mgr := newFakeExecutionClientManager(syncingClient, &syncingClient)
+	synced, clientToCheck, err := checkExecutionClientStatus(mgr, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if synced {
+		t.Fatal("syncing clients were treated as synced")
+	}
+	if clientToCheck != mgr.GetPrimaryClient() {
+		t.Fatalf("expected to wait for the primary client, but got %v", clientToCheck)
+	}
+
+	// The fallback client should be waited on if the primary client is down
+	mgr = newFakeExecutionClientManager(downClient, &syncingClient)
+	synced, clientToCheck, err = checkExecutionClientStatus(mgr, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if synced {
+		t.Fatal("a syncing fallback client was treated as synced")
+	}
+	if clientToCheck != mgr.GetFallbackClient() {
+		t.Fatalf("expected to wait for the fallback client, but got %v", clientToCheck)
+	}
+}
+
+func TestBothExecutionClientsDown(t *testing.T) {
+	for _, fallback := range []*api.ClientStatus{&downClient, nil} {
+		mgr := newFakeExecutionClientManager(downClient, fallback)
+		synced, err := waitForExecutionClientSync(mgr, newTestSyncConfig(1), false, 0)
+		if err == nil {
+			t.Fatal("expected an error when no execution clients are available")
+		}
+		if synced {
+			t.Fatal("unavailable clients were treated as synced")
+		}
+	}
+}
+
+func TestFlappingExecutionClientNeverConfirmsSync(t *testing.T) {
+	pollInterval := ethClientSyncPollInterval
+	refreshInterval := ethClientStatusRefreshInterval
+	ethClientSyncPollInterval = time.Millisecond
+	ethClientStatusRefreshInterval = 0
+	defer func() {
+		ethClientSyncPollInterval = pollInterval
+		ethClientStatusRefreshInterval = refreshInterval
+	}()
+
+	// The client is ready on every other check, so it never gets 3 in a row
+	mgr := &flappingExecutionClientManager{
+		fakeExecutionClientManager: newFakeExecutionClientManager(syncedClient, nil),
+		statuses:                   []api.ClientStatus{syncedClient, syncingClient},
+	}
+	synced, result, err := waitForExecutionClientSyncWithResult(mgr, newTestSyncConfig(3), false, 1)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if synced {
+		t.Fatalf("a flapping client was treated as synced after %d polls", result.PollCount)
+	}
+	if mgr.checks < 3 {
+		t.Fatalf("expected the status to be checked on every poll, but it was only checked %d times", mgr.checks)
+	}
+}
+
+func TestReadyExecutionClientNeedsConfirmations(t *testing.T) {
+	pollInterval := ethClientSyncPollInterval
+	ethClientSyncPollInterval = time.Millisecond
+	defer func() {
+		ethClientSyncPollInterval = pollInterval
+	}()
+
+	// A client that stays ready is confirmed once it has been seen 3 times in a row
+	mgr := &flappingExecutionClientManager{
+		fakeExecutionClientManager: newFakeExecutionClientManager(syncedClient, nil),
+		statuses:                   []api.ClientStatus{syncedClient},
+	}
+	synced, _, err := waitForExecutionClientSyncWithResult(mgr, newTestSyncConfig(3), false, 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if !synced {
+		t.Fatal("a client that stayed ready was not treated as synced")
+	}
+	if mgr.checks != 3 {
+		t.Fatalf("expected the sync to be confirmed on the 3rd check, but it took %d", mgr.checks)
+	}
+}
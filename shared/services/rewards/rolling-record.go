@@ -161,6 +161,37 @@ func (r *RollingRecord) GetScores(cheatingNodes map[common.Address]bool) ([]*Min
 	return minipoolInfos, totalScore, totalCount
 }
 
+// Estimate a node's share of the provided Smoothing Pool balance from the attestation scores recorded so far.
+// This uses the same formula as the rewards tree, so it will converge on the node's actual share as the interval progresses.
+// Returns the node's share, the total score of the node's minipools, and the number of the node's minipools that have been scored.
+func (r *RollingRecord) GetNodeSmoothingPoolShare(nodeAddress common.Address, smoothingPoolBalance *big.Int) (*big.Int, *big.Int, int) {
+	minipools, totalScore, attestationCount := r.GetScores(nil)
+
+	// Get the node's score
+	nodeScore := big.NewInt(0)
+	nodeMinipoolCount := 0
+	for _, mpInfo := range minipools {
+		if mpInfo.NodeAddress != nodeAddress {
+			continue
+		}
+		nodeScore.Add(nodeScore, &mpInfo.AttestationScore.Int)
+		nodeMinipoolCount++
+	}
+
+	// If there weren't any successful attestations, everything goes to the pool stakers
+	if totalScore.Sign() == 0 || attestationCount == 0 {
+		return big.NewInt(0), nodeScore, nodeMinipoolCount
+	}
+
+	// Get the node operators' share, then the node's portion of it
+	nodeShare := big.NewInt(0).Mul(smoothingPoolBalance, totalScore)
+	nodeShare.Div(nodeShare, big.NewInt(int64(attestationCount)))
+	nodeShare.Div(nodeShare, eth.EthToWei(1))
+	nodeShare.Mul(nodeShare, nodeScore)
+	nodeShare.Div(nodeShare, totalScore)
+	return nodeShare, nodeScore, nodeMinipoolCount
+}
+
 // Serialize the current record into a byte array
 func (r *RollingRecord) Serialize() ([]byte, error) {
 	// Clone the record
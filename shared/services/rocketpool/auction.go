@@ -33,9 +33,35 @@ func (c *Client) AuctionStatus() (api.AuctionStatusResponse, error) {
 	return response, nil
 }
 
+// Get the number of RPL lots that can be claimed, bid on, or recovered without loading every lot's details
+func (c *Client) AuctionLotCounts() (api.AuctionLotCounts, error) {
+	responseBytes, err := c.callAPI("auction lots --counts-only")
+	if err != nil {
+		return api.AuctionLotCounts{}, fmt.Errorf("Could not get auction lot counts: %w", err)
+	}
+	var response api.AuctionLotsResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.AuctionLotCounts{}, fmt.Errorf("Could not decode auction lots response: %w", err)
+	}
+	if response.Error != "" {
+		return api.AuctionLotCounts{}, fmt.Errorf("Could not get auction lot counts: %s", response.Error)
+	}
+	return response.LotCounts, nil
+}
+
 // Get RPL lots for auction
 func (c *Client) AuctionLots() (api.AuctionLotsResponse, error) {
-	responseBytes, err := c.callAPI("auction lots")
+	return c.getAuctionLots("auction lots")
+}
+
+// Get the RPL lots that can be bid on at or below a price (in wei), sorted by price ascending
+func (c *Client) AuctionLotsBelowPrice(maxPrice *big.Int) (api.AuctionLotsResponse, error) {
+	return c.getAuctionLots(fmt.Sprintf("auction lots --max-price %s", maxPrice.String()))
+}
+
+// Get RPL lots for auction with the provided command
+func (c *Client) getAuctionLots(command string) (api.AuctionLotsResponse, error) {
+	responseBytes, err := c.callAPI(command)
 	if err != nil {
 		return api.AuctionLotsResponse{}, fmt.Errorf("Could not get auction lots: %w", err)
 	}
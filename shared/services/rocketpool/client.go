@@ -56,6 +56,9 @@ const (
 
 	nethermindAdminUrl string = "http://127.0.0.1:7434"
 
+	DefaultApiCallRetries int           = 2
+	apiCallRetryDelay     time.Duration = 500 * time.Millisecond
+
 	DebugColor = color.FgYellow
 )
 
@@ -81,6 +84,7 @@ type Client struct {
 	debugPrint         bool
 	ignoreSyncCheck    bool
 	forceFallbacks     bool
+	apiCallRetries     int
 }
 
 func getClientStatusString(clientStatus api.ClientStatus) string {
@@ -157,6 +161,7 @@ func NewClientFromCtx(c *cli.Context) *Client {
 		debugPrint:         c.GlobalBool("debug"),
 		forceFallbacks:     false,
 		ignoreSyncCheck:    false,
+		apiCallRetries:     DefaultApiCallRetries,
 	}
 
 	if nonce, ok := c.App.Metadata["nonce"]; ok {
@@ -1006,6 +1011,11 @@ func (c *Client) SetClientStatusFlags(ignoreSyncCheck bool, forceFallbacks bool)
 	c.forceFallbacks = forceFallbacks
 }
 
+// Set the number of times an API call is retried if it can't reach the daemon
+func (c *Client) SetApiCallRetries(retries int) {
+	c.apiCallRetries = retries
+}
+
 // Get the command used to escalate privileges on the system
 func (c *Client) getEscalationCommand() (string, error) {
 	// Check for sudo first
@@ -1269,6 +1279,37 @@ func (c *Client) callAPI(args string, otherArgs ...string) ([]byte, error) {
 	return c.runApiCall(cmd)
 }
 
+// Call the Rocket Pool API with several commands at once, returning the raw response of each command in order.
+// Each response has its own status and error, so errors from individual commands must be checked by the caller.
+// The arguments of each command are split on whitespace, so they can't contain spaces.
+func (c *Client) callAPIBatch(commands []string) ([][]byte, error) {
+	responseBytes, err := c.callAPI("batch", commands...)
+	if err != nil {
+		return nil, fmt.Errorf("Could not run batch API call: %w", err)
+	}
+	return decodeBatchResponse(responseBytes, len(commands))
+}
+
+// Decode the response of a batch API call into the raw responses of its commands
+func decodeBatchResponse(responseBytes []byte, commandCount int) ([][]byte, error) {
+	var response api.BatchResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return nil, fmt.Errorf("Could not decode batch API response: %w", err)
+	}
+	if response.Error != "" {
+		return nil, fmt.Errorf("Could not run batch API call: %s", response.Error)
+	}
+	if len(response.Responses) != commandCount {
+		return nil, fmt.Errorf("Batch API call returned %d responses for %d commands", len(response.Responses), commandCount)
+	}
+
+	responses := make([][]byte, len(response.Responses))
+	for i, commandResponse := range response.Responses {
+		responses[i] = commandResponse
+	}
+	return responses, nil
+}
+
 // Call the Rocket Pool API with some custom environment variables
 func (c *Client) callAPIWithEnvVars(envVars map[string]string, args string, otherArgs ...string) ([]byte, error) {
 	// Sanitize and parse the args
@@ -1340,7 +1381,17 @@ func (c *Client) runApiCall(cmd string) ([]byte, error) {
 		fmt.Println(cmd)
 	}
 
+	// Retry connection-level failures with a backoff; errors returned by the daemon itself are part of the output and are never retried
 	output, err := c.readOutput(cmd)
+	delay := apiCallRetryDelay
+	for retry := 0; retry < c.apiCallRetries && err != nil && len(output) == 0 && isConnectionError(err); retry++ {
+		if c.debugPrint {
+			fmt.Printf("API call failed to reach the daemon (%s), retrying in %s...\n", err.Error(), delay)
+		}
+		time.Sleep(delay)
+		delay *= 2
+		output, err = c.readOutput(cmd)
+	}
 
 	if c.debugPrint {
 		if output != nil {
@@ -1361,6 +1412,23 @@ func (c *Client) runApiCall(cmd string) ([]byte, error) {
 	return output, err
 }
 
+// Check if an error from running an API call means the daemon couldn't be reached, rather than the call itself failing.
+// Only failures that happen before the command runs are included, since API calls may send transactions and must not be repeated.
+func isConnectionError(err error) bool {
+	// Couldn't open a session on the remote node
+	var sessionErr *sessionError
+	if errors.As(err, &sessionErr) {
+		return true
+	}
+
+	// Docker couldn't reach its daemon
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) {
+		return strings.Contains(string(exitErr.Stderr), "Cannot connect to the Docker daemon")
+	}
+	return false
+}
+
 // Get the API container name
 func (c *Client) getAPIContainerName() (string, error) {
 	cfg, _, err := c.LoadConfig()
@@ -0,0 +1,33 @@
+package rocketpool
+
+import (
+	"testing"
+)
+
+func TestBatchResponsesKeepPerCommandErrors(t *testing.T) {
+	responseBytes := []byte(`{"status":"success","error":"","responses":[{"status":"success","error":"","walletInitialized":true},{"status":"error","error":"The node is not registered."}]}`)
+	responses, err := decodeBatchResponse(responseBytes, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	walletStatus, err := decodeWalletStatusResponse(responses[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !walletStatus.WalletInitialized {
+		t.Fatal("expected the wallet to be initialized")
+	}
+	if _, err := decodeNodeStatusResponse(responses[1]); err == nil {
+		t.Fatal("expected the node status error to be returned")
+	}
+}
+
+func TestBatchResponseErrors(t *testing.T) {
+	if _, err := decodeBatchResponse([]byte(`{"status":"error","error":"Incorrect argument count"}`), 1); err == nil {
+		t.Fatal("expected the batch error to be returned")
+	}
+	if _, err := decodeBatchResponse([]byte(`{"status":"success","error":"","responses":[{"status":"success","error":""}]}`), 2); err == nil {
+		t.Fatal("expected an error when a response is missing")
+	}
+}
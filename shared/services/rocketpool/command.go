@@ -14,6 +14,19 @@ type command struct {
 	cmdText string
 }
 
+// An error opening an SSH session, before the command was sent to the remote node
+type sessionError struct {
+	err error
+}
+
+func (e *sessionError) Error() string {
+	return e.err.Error()
+}
+
+func (e *sessionError) Unwrap() error {
+	return e.err
+}
+
 // Create a command to be run by the Rocket Pool client
 func (c *Client) newCommand(cmdText string) (*command, error) {
 	if c.client == nil {
@@ -25,7 +38,7 @@ func (c *Client) newCommand(cmdText string) (*command, error) {
 
 	session, err := c.client.NewSession()
 	if err != nil {
-		return nil, err
+		return nil, &sessionError{err: err}
 	}
 	return &command{
 		session: session,
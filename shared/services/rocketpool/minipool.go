@@ -3,6 +3,8 @@ package rocketpool
 import (
 	"fmt"
 	"math/big"
+	"strconv"
+	"strings"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/goccy/go-json"
@@ -104,6 +106,121 @@ func (c *Client) CanStakeMinipool(address common.Address) (api.CanStakeMinipoolR
 	return response, nil
 }
 
+// Get the earliest time a prelaunch minipool can be staked
+func (c *Client) GetMinipoolStakeTime(address common.Address) (api.MinipoolStakeTimeResponse, error) {
+	responseBytes, err := c.callAPI(fmt.Sprintf("minipool get-stake-time %s", address.Hex()))
+	if err != nil {
+		return api.MinipoolStakeTimeResponse{}, fmt.Errorf("Could not get minipool stake time: %w", err)
+	}
+	var response api.MinipoolStakeTimeResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.MinipoolStakeTimeResponse{}, fmt.Errorf("Could not decode minipool stake time response: %w", err)
+	}
+	if response.Error != "" {
+		return api.MinipoolStakeTimeResponse{}, fmt.Errorf("Could not get minipool stake time: %s", response.Error)
+	}
+	return response, nil
+}
+
+// Simulate the collateral of a minipool's node at a set of hypothetical RPL prices (in ETH)
+func (c *Client) SimulateMinipoolCollateral(address common.Address, rplPrices []float64) (api.MinipoolCollateralSimulationResponse, error) {
+	prices := make([]string, len(rplPrices))
+	for i, rplPrice := range rplPrices {
+		prices[i] = strconv.FormatFloat(rplPrice, 'f', -1, 64)
+	}
+	responseBytes, err := c.callAPI(fmt.Sprintf("minipool simulate-collateral %s %s", address.Hex(), strings.Join(prices, ",")))
+	if err != nil {
+		return api.MinipoolCollateralSimulationResponse{}, fmt.Errorf("Could not simulate minipool collateral: %w", err)
+	}
+	var response api.MinipoolCollateralSimulationResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.MinipoolCollateralSimulationResponse{}, fmt.Errorf("Could not decode minipool collateral simulation response: %w", err)
+	}
+	if response.Error != "" {
+		return api.MinipoolCollateralSimulationResponse{}, fmt.Errorf("Could not simulate minipool collateral: %s", response.Error)
+	}
+	if response.RplStake == nil {
+		response.RplStake = big.NewInt(0)
+	}
+	if response.BorrowedEth == nil {
+		response.BorrowedEth = big.NewInt(0)
+	}
+	for i := range response.Results {
+		result := &response.Results[i]
+		if result.RplPrice == nil {
+			result.RplPrice = big.NewInt(0)
+		}
+		if result.MinimumStake == nil {
+			result.MinimumStake = big.NewInt(0)
+		}
+	}
+	return response, nil
+}
+
+// Get the next time an action can be taken on a minipool
+func (c *Client) GetMinipoolNextActionTime(address common.Address) (api.MinipoolNextActionTimeResponse, error) {
+	responseBytes, err := c.callAPI(fmt.Sprintf("minipool next-action-time %s", address.Hex()))
+	if err != nil {
+		return api.MinipoolNextActionTimeResponse{}, fmt.Errorf("Could not get minipool next action time: %w", err)
+	}
+	var response api.MinipoolNextActionTimeResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.MinipoolNextActionTimeResponse{}, fmt.Errorf("Could not decode minipool next action time response: %w", err)
+	}
+	if response.Error != "" {
+		return api.MinipoolNextActionTimeResponse{}, fmt.Errorf("Could not get minipool next action time: %s", response.Error)
+	}
+	return response, nil
+}
+
+// Get the estimated time at which an exiting minipool's validator balance becomes withdrawable
+func (c *Client) GetMinipoolExitTiming(address common.Address) (api.MinipoolExitTimingResponse, error) {
+	responseBytes, err := c.callAPI(fmt.Sprintf("minipool exit-timing %s", address.Hex()))
+	if err != nil {
+		return api.MinipoolExitTimingResponse{}, fmt.Errorf("Could not get minipool exit timing: %w", err)
+	}
+	var response api.MinipoolExitTimingResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.MinipoolExitTimingResponse{}, fmt.Errorf("Could not decode minipool exit timing response: %w", err)
+	}
+	if response.Error != "" {
+		return api.MinipoolExitTimingResponse{}, fmt.Errorf("Could not get minipool exit timing: %s", response.Error)
+	}
+	return response, nil
+}
+
+// Get a minipool's balance and node / user split at the slot it became withdrawable
+func (c *Client) GetMinipoolFinalizationDetails(address common.Address) (api.MinipoolFinalizationDetailsResponse, error) {
+	responseBytes, err := c.callAPI(fmt.Sprintf("minipool finalization-details %s", address.Hex()))
+	if err != nil {
+		return api.MinipoolFinalizationDetailsResponse{}, fmt.Errorf("Could not get minipool finalization details: %w", err)
+	}
+	var response api.MinipoolFinalizationDetailsResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.MinipoolFinalizationDetailsResponse{}, fmt.Errorf("Could not decode minipool finalization details response: %w", err)
+	}
+	if response.Error != "" {
+		return api.MinipoolFinalizationDetailsResponse{}, fmt.Errorf("Could not get minipool finalization details: %s", response.Error)
+	}
+	return response, nil
+}
+
+// Estimate a minipool's APR over the given number of recent epochs
+func (c *Client) GetMinipoolApr(address common.Address, epochs uint64) (api.MinipoolAprResponse, error) {
+	responseBytes, err := c.callAPI(fmt.Sprintf("minipool apr --epochs %d %s", epochs, address.Hex()))
+	if err != nil {
+		return api.MinipoolAprResponse{}, fmt.Errorf("Could not get minipool APR: %w", err)
+	}
+	var response api.MinipoolAprResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.MinipoolAprResponse{}, fmt.Errorf("Could not decode minipool APR response: %w", err)
+	}
+	if response.Error != "" {
+		return api.MinipoolAprResponse{}, fmt.Errorf("Could not get minipool APR: %s", response.Error)
+	}
+	return response, nil
+}
+
 // Stake a minipool
 func (c *Client) StakeMinipool(address common.Address) (api.StakeMinipoolResponse, error) {
 	responseBytes, err := c.callAPI(fmt.Sprintf("minipool stake %s", address.Hex()))
@@ -120,6 +237,22 @@ func (c *Client) StakeMinipool(address common.Address) (api.StakeMinipoolRespons
 	return response, nil
 }
 
+// Get the promotion details of all of the node's vacant minipools
+func (c *Client) GetMinipoolPromoteDetailsForNode() (api.GetMinipoolPromoteDetailsForNodeResponse, error) {
+	responseBytes, err := c.callAPI("minipool get-minipool-promote-details-for-node")
+	if err != nil {
+		return api.GetMinipoolPromoteDetailsForNodeResponse{}, fmt.Errorf("Could not get get-minipool-promote-details-for-node status: %w", err)
+	}
+	var response api.GetMinipoolPromoteDetailsForNodeResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.GetMinipoolPromoteDetailsForNodeResponse{}, fmt.Errorf("Could not decode get-minipool-promote-details-for-node response: %w", err)
+	}
+	if response.Error != "" {
+		return api.GetMinipoolPromoteDetailsForNodeResponse{}, fmt.Errorf("Could not get get-minipool-promote-details-for-node status: %s", response.Error)
+	}
+	return response, nil
+}
+
 // Check whether a minipool is eligible for promotion
 func (c *Client) CanPromoteMinipool(address common.Address) (api.CanPromoteMinipoolResponse, error) {
 	responseBytes, err := c.callAPI(fmt.Sprintf("minipool can-promote %s", address.Hex()))
@@ -248,6 +381,22 @@ func (c *Client) CloseMinipool(address common.Address) (api.CloseMinipoolRespons
 	return response, nil
 }
 
+// Get the delegate address and version of each of the node's minipools
+func (c *Client) GetMinipoolDelegateVersions() (api.MinipoolDelegateVersionsResponse, error) {
+	responseBytes, err := c.callAPI("minipool get-delegate-versions")
+	if err != nil {
+		return api.MinipoolDelegateVersionsResponse{}, fmt.Errorf("Could not get minipool delegate versions: %w", err)
+	}
+	var response api.MinipoolDelegateVersionsResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.MinipoolDelegateVersionsResponse{}, fmt.Errorf("Could not decode minipool delegate versions response: %w", err)
+	}
+	if response.Error != "" {
+		return api.MinipoolDelegateVersionsResponse{}, fmt.Errorf("Could not get minipool delegate versions: %s", response.Error)
+	}
+	return response, nil
+}
+
 // Check whether a minipool can have its delegate upgraded
 func (c *Client) CanDelegateUpgradeMinipool(address common.Address) (api.CanDelegateUpgradeResponse, error) {
 	responseBytes, err := c.callAPI(fmt.Sprintf("minipool can-delegate-upgrade %s", address.Hex()))
@@ -280,6 +429,38 @@ func (c *Client) DelegateUpgradeMinipool(address common.Address) (api.DelegateUp
 	return response, nil
 }
 
+// Check whether a set of minipools can have their delegates upgraded
+func (c *Client) CanUpgradeDelegates(addresses []common.Address) (api.CanUpgradeDelegatesResponse, error) {
+	responseBytes, err := c.callAPI(fmt.Sprintf("minipool can-upgrade-delegate %s", joinAddresses(addresses)))
+	if err != nil {
+		return api.CanUpgradeDelegatesResponse{}, fmt.Errorf("Could not get can upgrade delegates status: %w", err)
+	}
+	var response api.CanUpgradeDelegatesResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.CanUpgradeDelegatesResponse{}, fmt.Errorf("Could not decode can upgrade delegates response: %w", err)
+	}
+	if response.Error != "" {
+		return api.CanUpgradeDelegatesResponse{}, fmt.Errorf("Could not get can upgrade delegates status: %s", response.Error)
+	}
+	return response, nil
+}
+
+// Upgrade the delegates of a set of minipools, skipping the ones that can't be upgraded
+func (c *Client) UpgradeDelegates(addresses []common.Address) (api.UpgradeDelegatesResponse, error) {
+	responseBytes, err := c.callAPI(fmt.Sprintf("minipool upgrade-delegate %s", joinAddresses(addresses)))
+	if err != nil {
+		return api.UpgradeDelegatesResponse{}, fmt.Errorf("Could not upgrade minipool delegates: %w", err)
+	}
+	var response api.UpgradeDelegatesResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.UpgradeDelegatesResponse{}, fmt.Errorf("Could not decode upgrade delegates response: %w", err)
+	}
+	if response.Error != "" {
+		return api.UpgradeDelegatesResponse{}, fmt.Errorf("Could not upgrade minipool delegates: %s", response.Error)
+	}
+	return response, nil
+}
+
 // Check whether a minipool can have its delegate rolled back
 func (c *Client) CanDelegateRollbackMinipool(address common.Address) (api.CanDelegateRollbackResponse, error) {
 	responseBytes, err := c.callAPI(fmt.Sprintf("minipool can-delegate-rollback %s", address.Hex()))
@@ -456,6 +637,38 @@ func (c *Client) DistributeBalance(address common.Address) (api.DistributeBalanc
 	return response, nil
 }
 
+// Check whether a set of minipools can have their balances distributed
+func (c *Client) CanDistributeBalances(addresses []common.Address) (api.CanDistributeBalancesResponse, error) {
+	responseBytes, err := c.callAPI(fmt.Sprintf("minipool can-distribute-balances %s", joinAddresses(addresses)))
+	if err != nil {
+		return api.CanDistributeBalancesResponse{}, fmt.Errorf("Could not get can distribute balances status: %w", err)
+	}
+	var response api.CanDistributeBalancesResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.CanDistributeBalancesResponse{}, fmt.Errorf("Could not decode can distribute balances response: %w", err)
+	}
+	if response.Error != "" {
+		return api.CanDistributeBalancesResponse{}, fmt.Errorf("Could not get can distribute balances status: %s", response.Error)
+	}
+	return response, nil
+}
+
+// Distribute the balances of a set of minipools, skipping the ones that can't be distributed
+func (c *Client) DistributeBalances(addresses []common.Address) (api.DistributeBalancesResponse, error) {
+	responseBytes, err := c.callAPI(fmt.Sprintf("minipool distribute-balances %s", joinAddresses(addresses)))
+	if err != nil {
+		return api.DistributeBalancesResponse{}, fmt.Errorf("Could not distribute minipool balances: %w", err)
+	}
+	var response api.DistributeBalancesResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.DistributeBalancesResponse{}, fmt.Errorf("Could not decode distribute balances response: %w", err)
+	}
+	if response.Error != "" {
+		return api.DistributeBalancesResponse{}, fmt.Errorf("Could not distribute minipool balances: %s", response.Error)
+	}
+	return response, nil
+}
+
 // Import a validator private key for a vacant minipool
 func (c *Client) ImportKey(address common.Address, mnemonic string) (api.ChangeWithdrawalCredentialsResponse, error) {
 	responseBytes, err := c.callAPI(fmt.Sprintf("minipool import-key %s", address.Hex()), mnemonic)
@@ -472,6 +685,50 @@ func (c *Client) ImportKey(address common.Address, mnemonic string) (api.ChangeW
 	return response, nil
 }
 
+// Check the withdrawal credentials of the node's minipool validators against the ones their minipools expect
+func (c *Client) GetWithdrawalCredentialMismatches() (api.GetWithdrawalCredentialMismatchesResponse, error) {
+	responseBytes, err := c.callAPI("minipool get-withdrawal-credential-mismatches")
+	if err != nil {
+		return api.GetWithdrawalCredentialMismatchesResponse{}, fmt.Errorf("Could not get withdrawal credential mismatches: %w", err)
+	}
+	var response api.GetWithdrawalCredentialMismatchesResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.GetWithdrawalCredentialMismatchesResponse{}, fmt.Errorf("Could not decode withdrawal credential mismatches response: %w", err)
+	}
+	if response.Error != "" {
+		return api.GetWithdrawalCredentialMismatchesResponse{}, fmt.Errorf("Could not get withdrawal credential mismatches: %s", response.Error)
+	}
+	return response, nil
+}
+
+// Get the node's minipools that are in any of the provided statuses
+func (c *Client) GetNodeMinipoolsByStatus(statuses []string) (api.GetNodeMinipoolsByStatusResponse, error) {
+	if len(statuses) == 0 {
+		return api.GetNodeMinipoolsByStatusResponse{}, fmt.Errorf("Could not get minipools by status: no statuses were provided")
+	}
+	responseBytes, err := c.callAPI("minipool get-minipools-by-status", strings.Join(statuses, ","))
+	if err != nil {
+		return api.GetNodeMinipoolsByStatusResponse{}, fmt.Errorf("Could not get minipools by status: %w", err)
+	}
+	var response api.GetNodeMinipoolsByStatusResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.GetNodeMinipoolsByStatusResponse{}, fmt.Errorf("Could not decode minipools by status response: %w", err)
+	}
+	if response.Error != "" {
+		return api.GetNodeMinipoolsByStatusResponse{}, fmt.Errorf("Could not get minipools by status: %s", response.Error)
+	}
+	for i := range response.Minipools {
+		minipool := &response.Minipools[i]
+		if minipool.NodeFee == nil {
+			minipool.NodeFee = big.NewInt(0)
+		}
+		if minipool.NodeDepositBalance == nil {
+			minipool.NodeDepositBalance = big.NewInt(0)
+		}
+	}
+	return response, nil
+}
+
 // Check whether a solo validator's withdrawal creds can be migrated to a minipool address
 func (c *Client) CanChangeWithdrawalCredentials(address common.Address, mnemonic string) (api.CanChangeWithdrawalCredentialsResponse, error) {
 	responseBytes, err := c.callAPI(fmt.Sprintf("minipool can-change-withdrawal-creds %s", address.Hex()), mnemonic)
@@ -535,3 +792,12 @@ func (c *Client) RescueDissolvedMinipool(address common.Address, amount *big.Int
 	}
 	return response, nil
 }
+
+// Join a set of addresses into a comma-separated list
+func joinAddresses(addresses []common.Address) string {
+	hexes := make([]string, len(addresses))
+	for i, address := range addresses {
+		hexes[i] = address.Hex()
+	}
+	return strings.Join(hexes, ",")
+}
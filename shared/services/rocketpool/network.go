@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"math/big"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/goccy/go-json"
 	"github.com/rocket-pool/smartnode/shared/types/api"
 )
@@ -49,6 +50,82 @@ func (c *Client) RplPrice() (api.RplPriceResponse, error) {
 	return response, nil
 }
 
+// Get the current RPL inflation info
+func (c *Client) GetRplInflationInfo() (api.NetworkRplInflationInfoResponse, error) {
+	responseBytes, err := c.callAPI("network rpl-inflation-info")
+	if err != nil {
+		return api.NetworkRplInflationInfoResponse{}, fmt.Errorf("Could not get RPL inflation info: %w", err)
+	}
+	var response api.NetworkRplInflationInfoResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.NetworkRplInflationInfoResponse{}, fmt.Errorf("Could not decode RPL inflation info response: %w", err)
+	}
+	if response.Error != "" {
+		return api.NetworkRplInflationInfoResponse{}, fmt.Errorf("Could not get RPL inflation info: %s", response.Error)
+	}
+	if response.InflationIntervalRate == nil {
+		response.InflationIntervalRate = big.NewInt(0)
+	}
+	if response.TotalRplSupply == nil {
+		response.TotalRplSupply = big.NewInt(0)
+	}
+	if response.IntervalInflationAmount == nil {
+		response.IntervalInflationAmount = big.NewInt(0)
+	}
+	return response, nil
+}
+
+// Get whether the protocol is deployed and which of its gated actions are enabled
+func (c *Client) GetProtocolStatus() (api.NetworkProtocolStatusResponse, error) {
+	responseBytes, err := c.callAPI("network protocol-status")
+	if err != nil {
+		return api.NetworkProtocolStatusResponse{}, fmt.Errorf("Could not get protocol status: %w", err)
+	}
+	var response api.NetworkProtocolStatusResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.NetworkProtocolStatusResponse{}, fmt.Errorf("Could not decode protocol status response: %w", err)
+	}
+	if response.Error != "" {
+		return api.NetworkProtocolStatusResponse{}, fmt.Errorf("Could not get protocol status: %s", response.Error)
+	}
+	return response, nil
+}
+
+// Get the minimum and maximum RPL stake per minipool for each supported bond amount
+func (c *Client) GetRplStakeTiers() (api.NetworkRplStakeTiersResponse, error) {
+	responseBytes, err := c.callAPI("network rpl-stake-tiers")
+	if err != nil {
+		return api.NetworkRplStakeTiersResponse{}, fmt.Errorf("Could not get RPL stake tiers: %w", err)
+	}
+	var response api.NetworkRplStakeTiersResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.NetworkRplStakeTiersResponse{}, fmt.Errorf("Could not decode RPL stake tiers response: %w", err)
+	}
+	if response.Error != "" {
+		return api.NetworkRplStakeTiersResponse{}, fmt.Errorf("Could not get RPL stake tiers: %s", response.Error)
+	}
+	if response.RplPrice == nil {
+		response.RplPrice = big.NewInt(0)
+	}
+	return response, nil
+}
+
+// Compare the network values built into the Smartnode against the current on-chain values
+func (c *Client) CheckSettingsDrift() (api.NetworkSettingsDriftResponse, error) {
+	responseBytes, err := c.callAPI("network check-settings-drift")
+	if err != nil {
+		return api.NetworkSettingsDriftResponse{}, fmt.Errorf("Could not check settings drift: %w", err)
+	}
+	var response api.NetworkSettingsDriftResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.NetworkSettingsDriftResponse{}, fmt.Errorf("Could not decode settings drift response: %w", err)
+	}
+	if response.Error != "" {
+		return api.NetworkSettingsDriftResponse{}, fmt.Errorf("Could not check settings drift: %s", response.Error)
+	}
+	return response, nil
+}
+
 // Get network stats
 func (c *Client) NetworkStats() (api.NetworkStatsResponse, error) {
 	responseBytes, err := c.callAPI("network stats")
@@ -145,6 +222,22 @@ func (c *Client) DownloadRewardsFile(interval uint64) (api.DownloadRewardsFileRe
 	return response, nil
 }
 
+// Get a node's entry in the official rewards tree for a past interval
+func (c *Client) GetRewardsTreeNodeEntry(interval uint64, nodeAddress common.Address) (api.NetworkRewardsTreeNodeEntryResponse, error) {
+	responseBytes, err := c.callAPI(fmt.Sprintf("network get-rewards-tree-node-entry %d %s", interval, nodeAddress.Hex()))
+	if err != nil {
+		return api.NetworkRewardsTreeNodeEntryResponse{}, fmt.Errorf("Could not get rewards tree node entry: %w", err)
+	}
+	var response api.NetworkRewardsTreeNodeEntryResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.NetworkRewardsTreeNodeEntryResponse{}, fmt.Errorf("Could not decode rewards tree node entry response: %w", err)
+	}
+	if response.Error != "" {
+		return api.NetworkRewardsTreeNodeEntryResponse{}, fmt.Errorf("Could not get rewards tree node entry: %s", response.Error)
+	}
+	return response, nil
+}
+
 // Check if Atlas has been deployed yet
 func (c *Client) IsAtlasDeployed() (api.IsAtlasDeployedResponse, error) {
 	responseBytes, err := c.callAPI("network is-atlas-deployed")
@@ -176,3 +269,19 @@ func (c *Client) GetLatestDelegate() (api.GetLatestDelegateResponse, error) {
 	}
 	return response, nil
 }
+
+// Get the index, start, and expected end of the current rewards interval
+func (c *Client) GetRewardsIntervalInfo() (api.RewardsIntervalInfoResponse, error) {
+	responseBytes, err := c.callAPI("network rewards-interval-info")
+	if err != nil {
+		return api.RewardsIntervalInfoResponse{}, fmt.Errorf("Could not get rewards interval info: %w", err)
+	}
+	var response api.RewardsIntervalInfoResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.RewardsIntervalInfoResponse{}, fmt.Errorf("Could not decode rewards interval info response: %w", err)
+	}
+	if response.Error != "" {
+		return api.RewardsIntervalInfoResponse{}, fmt.Errorf("Could not get rewards interval info: %s", response.Error)
+	}
+	return response, nil
+}
@@ -21,6 +21,27 @@ func (c *Client) NodeStatus() (api.NodeStatusResponse, error) {
 	if err != nil {
 		return api.NodeStatusResponse{}, fmt.Errorf("Could not get node status: %w", err)
 	}
+	return decodeNodeStatusResponse(responseBytes)
+}
+
+// Get the wallet status and the node status with a single API call.
+// The node status can't be retrieved without an initialized wallet, so its error is returned separately from the wallet
+// status error and only needs to be checked once the wallet is known to be ready.
+func (c *Client) WalletAndNodeStatus() (api.WalletStatusResponse, api.NodeStatusResponse, error, error) {
+	responses, err := c.callAPIBatch([]string{"wallet status", "node status"})
+	if err != nil {
+		return api.WalletStatusResponse{}, api.NodeStatusResponse{}, nil, err
+	}
+	walletStatus, err := decodeWalletStatusResponse(responses[0])
+	if err != nil {
+		return api.WalletStatusResponse{}, api.NodeStatusResponse{}, nil, err
+	}
+	nodeStatus, nodeStatusErr := decodeNodeStatusResponse(responses[1])
+	return walletStatus, nodeStatus, nodeStatusErr, nil
+}
+
+// Decode the response of a node status call
+func decodeNodeStatusResponse(responseBytes []byte) (api.NodeStatusResponse, error) {
 	var response api.NodeStatusResponse
 	if err := json.Unmarshal(responseBytes, &response); err != nil {
 		return api.NodeStatusResponse{}, fmt.Errorf("Could not decode node status response: %w", err)
@@ -147,6 +168,22 @@ func (c *Client) ConfirmNodeWithdrawalAddress() (api.SetNodeWithdrawalAddressRes
 	return response, nil
 }
 
+// Get the node's current withdrawal address and any pending change that is awaiting confirmation
+func (c *Client) GetWithdrawalAddressStatus() (api.NodeWithdrawalAddressStatusResponse, error) {
+	responseBytes, err := c.callAPI("node get-withdrawal-address-status")
+	if err != nil {
+		return api.NodeWithdrawalAddressStatusResponse{}, fmt.Errorf("Could not get node withdrawal address status: %w", err)
+	}
+	var response api.NodeWithdrawalAddressStatusResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.NodeWithdrawalAddressStatusResponse{}, fmt.Errorf("Could not decode node withdrawal address status response: %w", err)
+	}
+	if response.Error != "" {
+		return api.NodeWithdrawalAddressStatusResponse{}, fmt.Errorf("Could not get node withdrawal address status: %s", response.Error)
+	}
+	return response, nil
+}
+
 // Checks if the node's timezone location can be set
 func (c *Client) CanSetNodeTimezone(timezoneLocation string) (api.CanSetNodeTimezoneResponse, error) {
 	responseBytes, err := c.callAPI("node can-set-timezone", timezoneLocation)
@@ -435,6 +472,23 @@ func (c *Client) NodeWithdrawRpl(amountWei *big.Int) (api.NodeWithdrawRplRespons
 	return response, nil
 }
 
+// Estimate the ETH, RPL, and gas required for the node to create a minipool with the provided bond.
+// Transactions that can't be simulated yet use rough gas limits and are flagged as such, as is the total gas cost.
+func (c *Client) GetMinipoolCreationCost(bondAmountWei *big.Int) (api.NodeMinipoolCreationCostResponse, error) {
+	responseBytes, err := c.callAPI(fmt.Sprintf("node get-minipool-creation-cost %s", bondAmountWei.String()))
+	if err != nil {
+		return api.NodeMinipoolCreationCostResponse{}, fmt.Errorf("Could not get minipool creation cost: %w", err)
+	}
+	var response api.NodeMinipoolCreationCostResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.NodeMinipoolCreationCostResponse{}, fmt.Errorf("Could not decode minipool creation cost response: %w", err)
+	}
+	if response.Error != "" {
+		return api.NodeMinipoolCreationCostResponse{}, fmt.Errorf("Could not get minipool creation cost: %s", response.Error)
+	}
+	return response, nil
+}
+
 // Check whether the node can make a deposit
 func (c *Client) CanNodeDeposit(amountWei *big.Int, minFee float64, salt *big.Int) (api.CanNodeDepositResponse, error) {
 	responseBytes, err := c.callAPI(fmt.Sprintf("node can-deposit %s %f %s", amountWei.String(), minFee, salt.String()))
@@ -739,6 +793,22 @@ func (c *Client) CanDistribute() (api.NodeCanDistributeResponse, error) {
 	return response, nil
 }
 
+// Get the node's fee distributor balance and how it would be split between the node and the protocol
+func (c *Client) GetDistributorBalanceSplit() (api.NodeDistributorBalanceSplitResponse, error) {
+	responseBytes, err := c.callAPI("node get-distributor-balance-split")
+	if err != nil {
+		return api.NodeDistributorBalanceSplitResponse{}, fmt.Errorf("Could not get distributor balance split: %w", err)
+	}
+	var response api.NodeDistributorBalanceSplitResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.NodeDistributorBalanceSplitResponse{}, fmt.Errorf("Could not decode distributor balance split response: %w", err)
+	}
+	if response.Error != "" {
+		return api.NodeDistributorBalanceSplitResponse{}, fmt.Errorf("Could not get distributor balance split: %s", response.Error)
+	}
+	return response, nil
+}
+
 // Distribute ETH from the node's fee distributor
 func (c *Client) Distribute() (api.NodeDistributeResponse, error) {
 	responseBytes, err := c.callAPI("node distribute")
@@ -771,6 +841,28 @@ func (c *Client) GetRewardsInfo() (api.NodeGetRewardsInfoResponse, error) {
 	return response, nil
 }
 
+// Get the past rewards intervals the node hasn't claimed yet, with the amounts owed for each
+func (c *Client) GetUnclaimedRewardsIntervals() (api.NodeUnclaimedRewardsIntervalsResponse, error) {
+	responseBytes, err := c.callAPI("node get-unclaimed-rewards-intervals")
+	if err != nil {
+		return api.NodeUnclaimedRewardsIntervalsResponse{}, fmt.Errorf("Could not get unclaimed rewards intervals: %w", err)
+	}
+	var response api.NodeUnclaimedRewardsIntervalsResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.NodeUnclaimedRewardsIntervalsResponse{}, fmt.Errorf("Could not decode unclaimed rewards intervals response: %w", err)
+	}
+	if response.Error != "" {
+		return api.NodeUnclaimedRewardsIntervalsResponse{}, fmt.Errorf("Could not get unclaimed rewards intervals: %s", response.Error)
+	}
+	if response.TotalRpl == nil {
+		response.TotalRpl = big.NewInt(0)
+	}
+	if response.TotalEth == nil {
+		response.TotalEth = big.NewInt(0)
+	}
+	return response, nil
+}
+
 // Check if the rewards for the given intervals can be claimed
 func (c *Client) CanNodeClaimRewards(indices []uint64) (api.CanNodeClaimRewardsResponse, error) {
 	indexStrings := []string{}
@@ -788,6 +880,12 @@ func (c *Client) CanNodeClaimRewards(indices []uint64) (api.CanNodeClaimRewardsR
 	if response.Error != "" {
 		return api.CanNodeClaimRewardsResponse{}, fmt.Errorf("Could not check if can claim rewards: %s", response.Error)
 	}
+	if response.TotalRpl == nil {
+		response.TotalRpl = big.NewInt(0)
+	}
+	if response.TotalEth == nil {
+		response.TotalEth = big.NewInt(0)
+	}
 	return response, nil
 }
 
@@ -808,6 +906,12 @@ func (c *Client) NodeClaimRewards(indices []uint64) (api.NodeClaimRewardsRespons
 	if response.Error != "" {
 		return api.NodeClaimRewardsResponse{}, fmt.Errorf("Could not claim rewards: %s", response.Error)
 	}
+	if response.TotalRpl == nil {
+		response.TotalRpl = big.NewInt(0)
+	}
+	if response.TotalEth == nil {
+		response.TotalEth = big.NewInt(0)
+	}
 	return response, nil
 }
 
@@ -828,6 +932,12 @@ func (c *Client) CanNodeClaimAndStakeRewards(indices []uint64, stakeAmountWei *b
 	if response.Error != "" {
 		return api.CanNodeClaimAndStakeRewardsResponse{}, fmt.Errorf("Could not check if can claim and stake rewards: %s", response.Error)
 	}
+	if response.TotalRpl == nil {
+		response.TotalRpl = big.NewInt(0)
+	}
+	if response.TotalEth == nil {
+		response.TotalEth = big.NewInt(0)
+	}
 	return response, nil
 }
 
@@ -848,6 +958,12 @@ func (c *Client) NodeClaimAndStakeRewards(indices []uint64, stakeAmountWei *big.
 	if response.Error != "" {
 		return api.NodeClaimAndStakeRewardsResponse{}, fmt.Errorf("Could not claim and stake rewards: %s", response.Error)
 	}
+	if response.TotalRpl == nil {
+		response.TotalRpl = big.NewInt(0)
+	}
+	if response.TotalEth == nil {
+		response.TotalEth = big.NewInt(0)
+	}
 	return response, nil
 }
 
@@ -867,6 +983,57 @@ func (c *Client) NodeGetSmoothingPoolRegistrationStatus() (api.GetSmoothingPoolR
 	return response, nil
 }
 
+// Get when the node last changed its Smoothing Pool status, and the earliest time it can change it again
+func (c *Client) NodeGetSmoothingPoolRegistrationChangeTime() (api.SmoothingPoolRegistrationChangeTimeResponse, error) {
+	responseBytes, err := c.callAPI("node get-smoothing-pool-registration-change-time")
+	if err != nil {
+		return api.SmoothingPoolRegistrationChangeTimeResponse{}, fmt.Errorf("Could not get smoothing pool registration change time: %w", err)
+	}
+	var response api.SmoothingPoolRegistrationChangeTimeResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.SmoothingPoolRegistrationChangeTimeResponse{}, fmt.Errorf("Could not decode smoothing pool registration change time response: %w", err)
+	}
+	if response.Error != "" {
+		return api.SmoothingPoolRegistrationChangeTimeResponse{}, fmt.Errorf("Could not get smoothing pool registration change time: %s", response.Error)
+	}
+	return response, nil
+}
+
+// Estimate the node's share of the current Smoothing Pool balance
+func (c *Client) NodeGetSmoothingPoolShare() (api.NodeSmoothingPoolShareResponse, error) {
+	responseBytes, err := c.callAPI("node get-smoothing-pool-share")
+	if err != nil {
+		return api.NodeSmoothingPoolShareResponse{}, fmt.Errorf("Could not get smoothing pool share: %w", err)
+	}
+	var response api.NodeSmoothingPoolShareResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.NodeSmoothingPoolShareResponse{}, fmt.Errorf("Could not decode smoothing pool share response: %w", err)
+	}
+	if response.Error != "" {
+		return api.NodeSmoothingPoolShareResponse{}, fmt.Errorf("Could not get smoothing pool share: %s", response.Error)
+	}
+	return response, nil
+}
+
+// Get the Smoothing Pool's balance, whether the node is opted into it, and the node's eligible minipool count for the current interval
+func (c *Client) GetSmoothingPoolStatus() (api.NodeSmoothingPoolStatusResponse, error) {
+	responseBytes, err := c.callAPI("node get-smoothing-pool-status")
+	if err != nil {
+		return api.NodeSmoothingPoolStatusResponse{}, fmt.Errorf("Could not get smoothing pool status: %w", err)
+	}
+	var response api.NodeSmoothingPoolStatusResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.NodeSmoothingPoolStatusResponse{}, fmt.Errorf("Could not decode smoothing pool status response: %w", err)
+	}
+	if response.Error != "" {
+		return api.NodeSmoothingPoolStatusResponse{}, fmt.Errorf("Could not get smoothing pool status: %s", response.Error)
+	}
+	if response.SmoothingPoolBalance == nil {
+		response.SmoothingPoolBalance = big.NewInt(0)
+	}
+	return response, nil
+}
+
 // Check if the node's Smoothing Pool status can be changed
 func (c *Client) CanNodeSetSmoothingPoolStatus(status bool) (api.CanSetSmoothingPoolRegistrationStatusResponse, error) {
 	responseBytes, err := c.callAPI(fmt.Sprintf("node can-set-smoothing-pool-status %t", status))
@@ -929,20 +1096,20 @@ func (c *Client) ReverseResolveEnsName(name string) (api.ResolveEnsNameResponse,
 }
 
 // Use the node private key to sign an arbitrary message
-func (c *Client) SignMessage(message string) (api.NodeSignResponse, error) {
+func (c *Client) SignMessage(message string) (api.NodeSignMessageResponse, error) {
 	// Ignore sync status so we can sign messages even without ready clients
 	c.ignoreSyncCheck = true
 	responseBytes, err := c.callAPI("node sign-message", message)
 	if err != nil {
-		return api.NodeSignResponse{}, fmt.Errorf("Could not sign message: %w", err)
+		return api.NodeSignMessageResponse{}, fmt.Errorf("Could not sign message: %w", err)
 	}
 
-	var response api.NodeSignResponse
+	var response api.NodeSignMessageResponse
 	if err := json.Unmarshal(responseBytes, &response); err != nil {
-		return api.NodeSignResponse{}, fmt.Errorf("Could not decode node sign response: %w", err)
+		return api.NodeSignMessageResponse{}, fmt.Errorf("Could not decode node sign response: %w", err)
 	}
 	if response.Error != "" {
-		return api.NodeSignResponse{}, fmt.Errorf("Could not sign message: %s", response.Error)
+		return api.NodeSignMessageResponse{}, fmt.Errorf("Could not sign message: %s", response.Error)
 	}
 	return response, nil
 }
@@ -1011,6 +1178,70 @@ func (c *Client) GetEthBalance() (api.NodeEthBalanceResponse, error) {
 	return response, nil
 }
 
+// Get the node's wallet, staked RPL, and withdrawal address balances
+func (c *Client) GetNodeBalances() (api.NodeBalancesResponse, error) {
+	responseBytes, err := c.callAPI("node get-balances")
+	if err != nil {
+		return api.NodeBalancesResponse{}, fmt.Errorf("Could not get node balances: %w", err)
+	}
+	var response api.NodeBalancesResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.NodeBalancesResponse{}, fmt.Errorf("Could not decode node balances response: %w", err)
+	}
+	if response.Error != "" {
+		return api.NodeBalancesResponse{}, fmt.Errorf("Could not get node balances: %s", response.Error)
+	}
+	return response, nil
+}
+
+// Get the node's timezone location, registration time, and fee distributor address
+func (c *Client) GetNodeMetadata() (api.NodeMetadataResponse, error) {
+	responseBytes, err := c.callAPI("node get-metadata")
+	if err != nil {
+		return api.NodeMetadataResponse{}, fmt.Errorf("Could not get node metadata: %w", err)
+	}
+	var response api.NodeMetadataResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.NodeMetadataResponse{}, fmt.Errorf("Could not decode node metadata response: %w", err)
+	}
+	if response.Error != "" {
+		return api.NodeMetadataResponse{}, fmt.Errorf("Could not get node metadata: %s", response.Error)
+	}
+	return response, nil
+}
+
+// Check whether any of the node's validators were live during the current or previous epoch
+func (c *Client) GetDoppelgangerRisk() (api.NodeDoppelgangerRiskResponse, error) {
+	responseBytes, err := c.callAPI("node get-doppelganger-risk")
+	if err != nil {
+		return api.NodeDoppelgangerRiskResponse{}, fmt.Errorf("Could not get doppelganger risk: %w", err)
+	}
+	var response api.NodeDoppelgangerRiskResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.NodeDoppelgangerRiskResponse{}, fmt.Errorf("Could not decode doppelganger risk response: %w", err)
+	}
+	if response.Error != "" {
+		return api.NodeDoppelgangerRiskResponse{}, fmt.Errorf("Could not get doppelganger risk: %s", response.Error)
+	}
+	return response, nil
+}
+
+// Get the node's borrowed collateral ratio now and at a previous slot
+func (c *Client) GetNodeCollateralRatioTrend(slot uint64) (api.NodeCollateralRatioTrendResponse, error) {
+	responseBytes, err := c.callAPI(fmt.Sprintf("node get-collateral-ratio-trend %d", slot))
+	if err != nil {
+		return api.NodeCollateralRatioTrendResponse{}, fmt.Errorf("Could not get node collateral ratio trend: %w", err)
+	}
+	var response api.NodeCollateralRatioTrendResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.NodeCollateralRatioTrendResponse{}, fmt.Errorf("Could not decode node collateral ratio trend response: %w", err)
+	}
+	if response.Error != "" {
+		return api.NodeCollateralRatioTrendResponse{}, fmt.Errorf("Could not get node collateral ratio trend: %s", response.Error)
+	}
+	return response, nil
+}
+
 // Estimates the gas for sending a zero-value message with a payload
 func (c *Client) CanSendMessage(address common.Address, message []byte) (api.CanNodeSendMessageResponse, error) {
 	responseBytes, err := c.callAPI(fmt.Sprintf("node can-send-message %s %s", address.Hex(), hex.EncodeToString(message)))
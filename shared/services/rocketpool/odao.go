@@ -48,6 +48,79 @@ func (c *Client) TNDAOMembers() (api.TNDAOMembersResponse, error) {
 	return response, nil
 }
 
+// Get the details of every oracle DAO member
+func (c *Client) GetOracleDaoMembers() (api.TNDAOMemberDetailsResponse, error) {
+	responseBytes, err := c.callAPI("odao member-details")
+	if err != nil {
+		return api.TNDAOMemberDetailsResponse{}, fmt.Errorf("Could not get oracle DAO member details: %w", err)
+	}
+	var response api.TNDAOMemberDetailsResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.TNDAOMemberDetailsResponse{}, fmt.Errorf("Could not decode oracle DAO member details response: %w", err)
+	}
+	if response.Error != "" {
+		return api.TNDAOMemberDetailsResponse{}, fmt.Errorf("Could not get oracle DAO member details: %s", response.Error)
+	}
+	if response.Members == nil {
+		response.Members = []api.TNDAOMemberDetails{}
+	}
+	for i := 0; i < len(response.Members); i++ {
+		member := &response.Members[i]
+		if member.RPLBondAmount == nil {
+			member.RPLBondAmount = big.NewInt(0)
+		}
+	}
+	return response, nil
+}
+
+// Get the prelaunch minipools that are still in their scrub window
+func (c *Client) TNDAOScrubQueue() (api.TNDAOScrubQueueResponse, error) {
+	responseBytes, err := c.callAPI("odao get-scrub-queue")
+	if err != nil {
+		return api.TNDAOScrubQueueResponse{}, fmt.Errorf("Could not get scrub queue: %w", err)
+	}
+	var response api.TNDAOScrubQueueResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.TNDAOScrubQueueResponse{}, fmt.Errorf("Could not decode scrub queue response: %w", err)
+	}
+	if response.Error != "" {
+		return api.TNDAOScrubQueueResponse{}, fmt.Errorf("Could not get scrub queue: %s", response.Error)
+	}
+	return response, nil
+}
+
+// Check whether the node can vote to scrub a set of minipools
+func (c *Client) CanVoteScrub(addresses []common.Address) (api.CanTNDAOVoteScrubResponse, error) {
+	responseBytes, err := c.callAPI(fmt.Sprintf("odao can-vote-scrub %s", joinAddresses(addresses)))
+	if err != nil {
+		return api.CanTNDAOVoteScrubResponse{}, fmt.Errorf("Could not get can vote scrub status: %w", err)
+	}
+	var response api.CanTNDAOVoteScrubResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.CanTNDAOVoteScrubResponse{}, fmt.Errorf("Could not decode can vote scrub response: %w", err)
+	}
+	if response.Error != "" {
+		return api.CanTNDAOVoteScrubResponse{}, fmt.Errorf("Could not get can vote scrub status: %s", response.Error)
+	}
+	return response, nil
+}
+
+// Vote to scrub a set of minipools, skipping the ones the node can't vote on
+func (c *Client) VoteScrub(addresses []common.Address) (api.TNDAOVoteScrubResponse, error) {
+	responseBytes, err := c.callAPI(fmt.Sprintf("odao vote-scrub %s", joinAddresses(addresses)))
+	if err != nil {
+		return api.TNDAOVoteScrubResponse{}, fmt.Errorf("Could not vote to scrub minipools: %w", err)
+	}
+	var response api.TNDAOVoteScrubResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.TNDAOVoteScrubResponse{}, fmt.Errorf("Could not decode vote scrub response: %w", err)
+	}
+	if response.Error != "" {
+		return api.TNDAOVoteScrubResponse{}, fmt.Errorf("Could not vote to scrub minipools: %s", response.Error)
+	}
+	return response, nil
+}
+
 // Get oracle DAO proposals
 func (c *Client) TNDAOProposals() (api.TNDAOProposalsResponse, error) {
 	responseBytes, err := c.callAPI("odao proposals")
@@ -31,6 +31,50 @@ func (c *Client) QueueStatus() (api.QueueStatusResponse, error) {
 	return response, nil
 }
 
+// Get the deposit pool's balance, capacity, and whether deposits are enabled
+func (c *Client) GetDepositPoolStatus() (api.DepositPoolStatusResponse, error) {
+	responseBytes, err := c.callAPI("queue deposit-pool-status")
+	if err != nil {
+		return api.DepositPoolStatusResponse{}, fmt.Errorf("Could not get deposit pool status: %w", err)
+	}
+	var response api.DepositPoolStatusResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.DepositPoolStatusResponse{}, fmt.Errorf("Could not decode deposit pool status response: %w", err)
+	}
+	if response.Error != "" {
+		return api.DepositPoolStatusResponse{}, fmt.Errorf("Could not get deposit pool status: %s", response.Error)
+	}
+	if response.Balance == nil {
+		response.Balance = big.NewInt(0)
+	}
+	if response.ExcessBalance == nil {
+		response.ExcessBalance = big.NewInt(0)
+	}
+	if response.Capacity == nil {
+		response.Capacity = big.NewInt(0)
+	}
+	return response, nil
+}
+
+// Get the queue positions of the node's minipools
+func (c *Client) QueuePositions() (api.MinipoolQueuePositionsResponse, error) {
+	responseBytes, err := c.callAPI("queue positions")
+	if err != nil {
+		return api.MinipoolQueuePositionsResponse{}, fmt.Errorf("Could not get queue positions: %w", err)
+	}
+	var response api.MinipoolQueuePositionsResponse
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return api.MinipoolQueuePositionsResponse{}, fmt.Errorf("Could not decode queue positions response: %w", err)
+	}
+	if response.Error != "" {
+		return api.MinipoolQueuePositionsResponse{}, fmt.Errorf("Could not get queue positions: %s", response.Error)
+	}
+	if response.FillRatePerHour == nil {
+		response.FillRatePerHour = big.NewInt(0)
+	}
+	return response, nil
+}
+
 // Check whether the queue can be processed
 func (c *Client) CanProcessQueue() (api.CanProcessQueueResponse, error) {
 	responseBytes, err := c.callAPI("queue can-process")
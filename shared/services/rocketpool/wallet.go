@@ -15,6 +15,11 @@ func (c *Client) WalletStatus() (api.WalletStatusResponse, error) {
 	if err != nil {
 		return api.WalletStatusResponse{}, fmt.Errorf("Could not get wallet status: %w", err)
 	}
+	return decodeWalletStatusResponse(responseBytes)
+}
+
+// Decode the response of a wallet status call
+func decodeWalletStatusResponse(responseBytes []byte) (api.WalletStatusResponse, error) {
 	var response api.WalletStatusResponse
 	if err := json.Unmarshal(responseBytes, &response); err != nil {
 		return api.WalletStatusResponse{}, fmt.Errorf("Could not decode wallet status response: %w", err)
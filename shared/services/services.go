@@ -43,6 +43,8 @@ var (
 	snapshotDelegation *contracts.SnapshotDelegation
 	beaconClient       beacon.Client
 	docker             *client.Client
+	stateReadEc        *ethclient.Client
+	stateReadRp        *rocketpool.RocketPool
 
 	initCfg                sync.Once
 	initPasswordManager    sync.Once
@@ -55,6 +57,7 @@ var (
 	initSnapshotDelegation sync.Once
 	initBeaconClient       sync.Once
 	initDocker             sync.Once
+	initStateReadClient    sync.Once
 )
 
 //
@@ -113,6 +116,16 @@ func GetRocketPool(c *cli.Context) (*rocketpool.RocketPool, error) {
 	return getRocketPool(cfg, ec)
 }
 
+// Get the separate EC used for the heavy reads of network state builds, and a Rocket Pool binding that uses it.
+// Both are nil if a state read EC isn't configured. This is meant for the long-lived daemons, which keep the connection open.
+func GetStateReadClient(c *cli.Context) (*rocketpool.RocketPool, *ethclient.Client, error) {
+	cfg, err := getConfig(c)
+	if err != nil {
+		return nil, nil, err
+	}
+	return getStateReadClient(cfg)
+}
+
 func GetRplFaucet(c *cli.Context) (*contracts.RPLFaucet, error) {
 	cfg, err := getConfig(c)
 	if err != nil {
@@ -204,6 +217,16 @@ func getWallet(c *cli.Context, cfg *config.RocketPoolConfig, pm *passwords.Passw
 			return
 		}
 
+		// Observe the configured node instead of the wallet's if requested
+		observerAddress := cfg.Smartnode.ObserverNodeAddress.Value.(string)
+		if observerAddress != "" {
+			if !common.IsHexAddress(observerAddress) {
+				err = fmt.Errorf("Invalid observer node address '%s'", observerAddress)
+				return
+			}
+			nodeWallet.SetNodeAddressSource(wallet.NewObserverAddressSource(common.HexToAddress(observerAddress)))
+		}
+
 		// Keystores
 		lighthouseKeystore := lhkeystore.NewKeystore(os.ExpandEnv(cfg.Smartnode.GetValidatorKeychainPath()), pm)
 		lodestarKeystore := lokeystore.NewKeystore(os.ExpandEnv(cfg.Smartnode.GetValidatorKeychainPath()), pm)
@@ -245,6 +268,31 @@ func getRocketPool(cfg *config.RocketPoolConfig, client rocketpool.ExecutionClie
 	return rocketPool, err
 }
 
+func getStateReadClient(cfg *config.RocketPoolConfig) (*rocketpool.RocketPool, *ethclient.Client, error) {
+	var err error
+	initStateReadClient.Do(func() {
+		stateReadEcUrl := cfg.Smartnode.StateReadECUrl.Value.(string)
+		if stateReadEcUrl == "" {
+			return
+		}
+		var ec *ethclient.Client
+		ec, err = dialStateReadClient(cfg.Smartnode, stateReadEcUrl)
+		if err != nil {
+			return
+		}
+		var rp *rocketpool.RocketPool
+		rp, err = rocketpool.NewRocketPool(ec, common.HexToAddress(cfg.Smartnode.GetStorageAddress()))
+		if err != nil {
+			ec.Close()
+			err = fmt.Errorf("error creating Rocket Pool binding for the state read EC: %w", err)
+			return
+		}
+		stateReadEc = ec
+		stateReadRp = rp
+	})
+	return stateReadRp, stateReadEc, err
+}
+
 func getRplFaucet(cfg *config.RocketPoolConfig, client rocketpool.ExecutionClient) (*contracts.RPLFaucet, error) {
 	var err error
 	initRplFaucet.Do(func() {
@@ -0,0 +1,122 @@
+package state
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rocket-pool/smartnode/shared/services/beacon"
+	"golang.org/x/sync/errgroup"
+)
+
+// The attestation participation of Rocket Pool validators during an epoch.
+// Validators that don't belong to a minipool in the network state are not included, so this is not
+// the participation of the Beacon chain as a whole.
+type AttestationParticipation struct {
+	Epoch                    uint64
+	ExpectedAttestations     int
+	SuccessfulAttestations   int
+	ParticipationRate        float64
+	UnderperformingMinipools int
+}
+
+// Get the attestation participation of the Rocket Pool validators in the state for the epoch containing the state's slot.
+// Attestations can be included up to an epoch after their duty, so blocks that haven't been proposed yet can't be checked;
+// for a recent state, some late attestations may not be counted yet.
+func (s *NetworkState) GetAttestationParticipation(bc beacon.Client) (AttestationParticipation, error) {
+	slotsPerEpoch := s.BeaconConfig.SlotsPerEpoch
+	epoch := s.BeaconSlotNumber / slotsPerEpoch
+	participation := AttestationParticipation{
+		Epoch: epoch,
+	}
+
+	// Get the attestation committees for the epoch
+	committees, err := bc.GetCommitteesForEpoch(&epoch)
+	if err != nil {
+		return participation, fmt.Errorf("error getting committees for epoch %d: %w", epoch, err)
+	}
+	defer committees.Release()
+
+	// Find the position of each RP validator in the committees, by slot and committee index
+	duties := map[uint64]map[uint64]map[int]common.Address{}
+	for idx := 0; idx < committees.Count(); idx++ {
+		slot := committees.Slot(idx)
+		committeeIndex := committees.Index(idx)
+		for position, validator := range committees.Validators(idx) {
+			index, err := strconv.ParseUint(validator, 10, 64)
+			if err != nil {
+				return participation, fmt.Errorf("invalid validator index %s in committee %d of slot %d: %w", validator, committeeIndex, slot, err)
+			}
+			mpd, _, err := s.GetMinipoolByValidatorIndex(index)
+			if err != nil {
+				// This isn't an RP validator, so ignore it
+				continue
+			}
+
+			slotDuties, exists := duties[slot]
+			if !exists {
+				slotDuties = map[uint64]map[int]common.Address{}
+				duties[slot] = slotDuties
+			}
+			committeeDuties, exists := slotDuties[committeeIndex]
+			if !exists {
+				committeeDuties = map[int]common.Address{}
+				slotDuties[committeeIndex] = committeeDuties
+			}
+			committeeDuties[position] = mpd.MinipoolAddress
+			participation.ExpectedAttestations++
+		}
+	}
+	if participation.ExpectedAttestations == 0 {
+		return participation, nil
+	}
+
+	// Get the attestations included in the epoch and the one after it, since they can be included up to 32 slots late
+	startSlot := epoch * slotsPerEpoch
+	attestationsPerSlot := make([][]beacon.AttestationInfo, 2*slotsPerEpoch)
+	var wg errgroup.Group
+	wg.SetLimit(threadLimit)
+	for i := range attestationsPerSlot {
+		i := i
+		slot := startSlot + uint64(i)
+		wg.Go(func() error {
+			attestations, found, err := bc.GetAttestations(fmt.Sprint(slot))
+			if err != nil {
+				return fmt.Errorf("error getting attestations for slot %d: %w", slot, err)
+			}
+			if found {
+				attestationsPerSlot[i] = attestations
+			}
+			return nil
+		})
+	}
+	if err := wg.Wait(); err != nil {
+		return participation, fmt.Errorf("error getting attestation records for epoch %d: %w", epoch, err)
+	}
+
+	// Remove each duty that was fulfilled by an included attestation
+	for i, attestations := range attestationsPerSlot {
+		inclusionSlot := startSlot + uint64(i)
+		for _, attestation := range attestations {
+			slotDuties, exists := duties[attestation.SlotIndex]
+			if !exists || inclusionSlot-attestation.SlotIndex > slotsPerEpoch {
+				continue
+			}
+			committeeDuties, exists := slotDuties[attestation.CommitteeIndex]
+			if !exists {
+				continue
+			}
+			for position := range committeeDuties {
+				if attestation.AggregationBits.BitAt(uint64(position)) {
+					delete(committeeDuties, position)
+					participation.SuccessfulAttestations++
+				}
+			}
+		}
+	}
+
+	// Every validator has one attestation duty per epoch, so each remaining duty is an underperforming minipool
+	participation.UnderperformingMinipools = participation.ExpectedAttestations - participation.SuccessfulAttestations
+	participation.ParticipationRate = float64(participation.SuccessfulAttestations) / float64(participation.ExpectedAttestations)
+	return participation, nil
+}
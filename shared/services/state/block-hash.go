@@ -0,0 +1,138 @@
+package state
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/rocket-pool/rocketpool-go/rocketpool"
+
+	"github.com/rocket-pool/smartnode/shared/services/beacon"
+	"github.com/rocket-pool/smartnode/shared/services/config"
+	"github.com/rocket-pool/smartnode/shared/utils/log"
+)
+
+// A raw JSON-RPC connection to an Execution client, such as an *rpc.Client.
+// It's used to make calls by block hash (EIP-1898), which the ExecutionClient interface doesn't support.
+type BlockHashCaller interface {
+	CallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error
+}
+
+// An Execution client that runs every call at a pinned block number against that block's hash instead, so a reorg
+// during the state build makes the calls fail rather than silently mixing data from two different blocks
+type blockHashPinnedClient struct {
+	rocketpool.ExecutionClient
+	caller      BlockHashCaller
+	blockNumber *big.Int
+	blockHash   common.Hash
+}
+
+// Get the EIP-1898 block parameter for the pinned block, or nil if the block number isn't the pinned one
+func (c *blockHashPinnedClient) getBlockArg(blockNumber *big.Int) interface{} {
+	if blockNumber == nil || blockNumber.Cmp(c.blockNumber) != 0 {
+		return nil
+	}
+	return map[string]interface{}{
+		"blockHash":        c.blockHash,
+		"requireCanonical": true,
+	}
+}
+
+// Run a contract call, using the pinned block hash if the call is for the pinned block
+func (c *blockHashPinnedClient) CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	blockArg := c.getBlockArg(blockNumber)
+	if blockArg == nil {
+		return c.ExecutionClient.CallContract(ctx, call, blockNumber)
+	}
+	arg := map[string]interface{}{
+		"from": call.From,
+		"to":   call.To,
+	}
+	if len(call.Data) > 0 {
+		arg["data"] = hexutil.Bytes(call.Data)
+	}
+	if call.Value != nil {
+		arg["value"] = (*hexutil.Big)(call.Value)
+	}
+	if call.Gas != 0 {
+		arg["gas"] = hexutil.Uint64(call.Gas)
+	}
+	var result hexutil.Bytes
+	if err := c.caller.CallContext(ctx, &result, "eth_call", arg, blockArg); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// Get the code of a contract, using the pinned block hash if the request is for the pinned block
+func (c *blockHashPinnedClient) CodeAt(ctx context.Context, contract common.Address, blockNumber *big.Int) ([]byte, error) {
+	blockArg := c.getBlockArg(blockNumber)
+	if blockArg == nil {
+		return c.ExecutionClient.CodeAt(ctx, contract, blockNumber)
+	}
+	var result hexutil.Bytes
+	if err := c.caller.CallContext(ctx, &result, "eth_getCode", contract, blockArg); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// Get the balance of an account, using the pinned block hash if the request is for the pinned block
+func (c *blockHashPinnedClient) BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error) {
+	blockArg := c.getBlockArg(blockNumber)
+	if blockArg == nil {
+		return c.ExecutionClient.BalanceAt(ctx, account, blockNumber)
+	}
+	var result hexutil.Big
+	if err := c.caller.CallContext(ctx, &result, "eth_getBalance", account, blockArg); err != nil {
+		return nil, err
+	}
+	return (*big.Int)(&result), nil
+}
+
+// Creates a snapshot of the entire Rocket Pool network state at the EL block with the provided hash.
+// Every contract read is made against the block hash rather than its number, so the snapshot is self-consistent even if the
+// chain reorgs during the build; the build fails instead if the block stops being canonical. The EC must support calls by block
+// hash (EIP-1898) through the provided caller.
+func CreateNetworkStateAtBlockHash(cfg *config.RocketPoolConfig, rp *rocketpool.RocketPool, ec rocketpool.ExecutionClient, caller BlockHashCaller, bc beacon.Client, log *log.ColorLogger, blockHash common.Hash, beaconConfig beacon.Eth2Config) (*NetworkState, error) {
+	// Get the block
+	header, err := ec.HeaderByHash(context.Background(), blockHash)
+	if err != nil {
+		return nil, fmt.Errorf("error getting EL block %s: %w", blockHash.Hex(), err)
+	}
+	pinnedClient := &blockHashPinnedClient{
+		ExecutionClient: ec,
+		caller:          caller,
+		blockNumber:     header.Number,
+		blockHash:       blockHash,
+	}
+
+	// Make sure the EC supports calls by block hash
+	_, err = pinnedClient.CodeAt(context.Background(), *rp.RocketStorageContract.Address, header.Number)
+	if err != nil {
+		return nil, fmt.Errorf("the Execution client does not support calls by block hash: %w", err)
+	}
+
+	// Post-merge, every EL block belongs to the Beacon slot at its timestamp
+	if header.Time < beaconConfig.GenesisTime || beaconConfig.SecondsPerSlot == 0 {
+		return nil, fmt.Errorf("EL block %s (%d) is before the Beacon chain genesis", blockHash.Hex(), header.Number.Uint64())
+	}
+	slotNumber := (header.Time - beaconConfig.GenesisTime) / beaconConfig.SecondsPerSlot
+	beaconBlock, exists, err := bc.GetBeaconBlock(fmt.Sprint(slotNumber))
+	if err != nil {
+		return nil, fmt.Errorf("error getting Beacon block for slot %d: %w", slotNumber, err)
+	}
+	if !exists || beaconBlock.ExecutionBlockNumber != header.Number.Uint64() {
+		return nil, fmt.Errorf("EL block %s (%d) is not the execution payload of Beacon slot %d", blockHash.Hex(), header.Number.Uint64(), slotNumber)
+	}
+
+	// Build the state with a Rocket Pool binding that uses the pinned client
+	pinnedRp, err := rocketpool.NewRocketPool(pinnedClient, *rp.RocketStorageContract.Address)
+	if err != nil {
+		return nil, fmt.Errorf("error creating Rocket Pool binding pinned to block %s: %w", blockHash.Hex(), err)
+	}
+	return createNetworkState(cfg, pinnedRp, pinnedClient, bc, log, slotNumber, beaconConfig, nil)
+}
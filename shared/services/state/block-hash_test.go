@@ -0,0 +1,67 @@
+package state
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/rocket-pool/rocketpool-go/rocketpool"
+)
+
+// A caller that records the block parameter of each request
+type recordingCaller struct {
+	blockArgs []interface{}
+}
+
+func (c *recordingCaller) CallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error {
+	c.blockArgs = append(c.blockArgs, args[len(args)-1])
+	*result.(*hexutil.Bytes) = hexutil.Bytes{0x01}
+	return nil
+}
+
+// An Execution client that only counts its contract calls
+type countingClient struct {
+	rocketpool.ExecutionClient
+	calls int
+}
+
+func (c *countingClient) CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	c.calls++
+	return nil, nil
+}
+
+func TestPinnedClientUsesBlockHash(t *testing.T) {
+	caller := &recordingCaller{}
+	ec := &countingClient{}
+	blockHash := common.HexToHash("0x1234")
+	client := &blockHashPinnedClient{
+		ExecutionClient: ec,
+		caller:          caller,
+		blockNumber:     big.NewInt(100),
+		blockHash:       blockHash,
+	}
+
+	// Calls at the pinned block go to the caller with the block hash
+	to := common.HexToAddress("0x01")
+	if _, err := client.CallContract(context.Background(), ethereum.CallMsg{To: &to}, big.NewInt(100)); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if len(caller.blockArgs) != 1 || ec.calls != 0 {
+		t.Fatalf("expected 1 pinned call and 0 unpinned calls, got %d and %d", len(caller.blockArgs), ec.calls)
+	}
+	blockArg := caller.blockArgs[0].(map[string]interface{})
+	if blockArg["blockHash"] != blockHash || blockArg["requireCanonical"] != true {
+		t.Errorf("unexpected block parameter %v", blockArg)
+	}
+
+	// Calls at any other block are left alone
+	if _, err := client.CallContract(context.Background(), ethereum.CallMsg{To: &to}, big.NewInt(99)); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if len(caller.blockArgs) != 1 || ec.calls != 1 {
+		t.Errorf("expected 1 pinned call and 1 unpinned call, got %d and %d", len(caller.blockArgs), ec.calls)
+	}
+}
@@ -0,0 +1,62 @@
+package state
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// A minipool with a bond reduction that has been started but not yet cancelled or executed
+type PendingBondReduction struct {
+	MinipoolAddress common.Address
+	NodeAddress     common.Address
+	CurrentBond     *big.Int
+	NewBond         *big.Int
+	RequestTime     time.Time
+	ExecutableTime  time.Time
+	ExecutableSlot  uint64
+	WindowEndTime   time.Time
+	IsExecutable    bool
+}
+
+// Get all of the minipools in the network with a pending bond reduction, along with the time and Beacon slot at which each one
+// can be executed. Reductions whose window has already ended are still included, since they haven't been cancelled or executed.
+func (s *NetworkState) GetPendingBondReductions() []PendingBondReduction {
+	genesisTime := time.Unix(int64(s.BeaconConfig.GenesisTime), 0)
+	secondsPerSlot := s.BeaconConfig.SecondsPerSlot
+	slotTime := genesisTime.Add(time.Duration(s.BeaconSlotNumber*secondsPerSlot) * time.Second)
+	windowStart := s.NetworkDetails.BondReductionWindowStart
+	windowLength := s.NetworkDetails.BondReductionWindowLength
+
+	reductions := []PendingBondReduction{}
+	for _, mpd := range s.MinipoolDetails {
+		if mpd.Finalised || mpd.ReduceBondCancelled || mpd.ReduceBondTime == nil || mpd.ReduceBondTime.Sign() == 0 {
+			continue
+		}
+
+		requestTime := time.Unix(mpd.ReduceBondTime.Int64(), 0)
+		executableTime := requestTime.Add(windowStart)
+		windowEndTime := executableTime.Add(windowLength)
+
+		// Round up to the first slot at or after the executable time
+		var executableSlot uint64
+		if secondsPerSlot > 0 && executableTime.After(genesisTime) {
+			secondsSinceGenesis := uint64(executableTime.Sub(genesisTime) / time.Second)
+			executableSlot = (secondsSinceGenesis + secondsPerSlot - 1) / secondsPerSlot
+		}
+
+		reductions = append(reductions, PendingBondReduction{
+			MinipoolAddress: mpd.MinipoolAddress,
+			NodeAddress:     mpd.NodeAddress,
+			CurrentBond:     mpd.NodeDepositBalance,
+			NewBond:         mpd.ReduceBondValue,
+			RequestTime:     requestTime,
+			ExecutableTime:  executableTime,
+			ExecutableSlot:  executableSlot,
+			WindowEndTime:   windowEndTime,
+			IsExecutable:    !slotTime.Before(executableTime) && slotTime.Before(windowEndTime),
+		})
+	}
+	return reductions
+}
@@ -0,0 +1,41 @@
+package state
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rocket-pool/rocketpool-go/types"
+)
+
+// A node's commission across its active minipools; fees are fractions scaled by 1e18
+type NodeCommission struct {
+	HasActiveMinipools bool
+	WeightedAverageFee *big.Int
+	MinipoolFees       map[common.Address]*big.Int
+}
+
+// Get the average node fee of a node's active (staking and not finalized) minipools, weighted by each minipool's bond.
+// Nodes without any active minipools have an average of zero.
+func (s *NetworkState) GetNodeWeightedAverageFee(nodeAddress common.Address) NodeCommission {
+	commission := NodeCommission{
+		WeightedAverageFee: big.NewInt(0),
+		MinipoolFees:       map[common.Address]*big.Int{},
+	}
+
+	totalBond := big.NewInt(0)
+	weightedFees := big.NewInt(0)
+	for _, mpd := range s.MinipoolDetailsByNode[nodeAddress] {
+		if mpd.Status != types.Staking || mpd.Finalised {
+			continue
+		}
+		commission.MinipoolFees[mpd.MinipoolAddress] = big.NewInt(0).Set(mpd.NodeFee)
+		weightedFees.Add(weightedFees, big.NewInt(0).Mul(mpd.NodeFee, mpd.NodeDepositBalance))
+		totalBond.Add(totalBond, mpd.NodeDepositBalance)
+	}
+
+	commission.HasActiveMinipools = len(commission.MinipoolFees) > 0
+	if totalBond.Sign() > 0 {
+		commission.WeightedAverageFee.Div(weightedFees, totalBond)
+	}
+	return commission
+}
@@ -0,0 +1,123 @@
+package state
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rocket-pool/rocketpool-go/types"
+)
+
+// The changes between two network states. Only nodes and minipools that changed are included.
+type StateDiff struct {
+	FromSlot         uint64           `json:"fromSlot"`
+	ToSlot           uint64           `json:"toSlot"`
+	AddedNodes       []common.Address `json:"addedNodes,omitempty"`
+	RemovedNodes     []common.Address `json:"removedNodes,omitempty"`
+	Nodes            []NodeDiff       `json:"nodes,omitempty"`
+	AddedMinipools   []common.Address `json:"addedMinipools,omitempty"`
+	RemovedMinipools []common.Address `json:"removedMinipools,omitempty"`
+	Minipools        []MinipoolDiff   `json:"minipools,omitempty"`
+}
+
+// The changes to a node that exists in both states; deltas that are zero are nil
+type NodeDiff struct {
+	NodeAddress            common.Address `json:"nodeAddress"`
+	RplStakeDelta          *big.Int       `json:"rplStakeDelta,omitempty"`
+	EffectiveRplStakeDelta *big.Int       `json:"effectiveRplStakeDelta,omitempty"`
+	EthMatchedDelta        *big.Int       `json:"ethMatchedDelta,omitempty"`
+	BalanceEthDelta        *big.Int       `json:"balanceEthDelta,omitempty"`
+	SmoothingPoolChanged   bool           `json:"smoothingPoolChanged,omitempty"`
+}
+
+// The changes to a minipool that exists in both states; deltas that are zero are nil
+type MinipoolDiff struct {
+	MinipoolAddress    common.Address        `json:"minipoolAddress"`
+	NodeAddress        common.Address        `json:"nodeAddress"`
+	PreviousStatus     *types.MinipoolStatus `json:"previousStatus,omitempty"`
+	Status             *types.MinipoolStatus `json:"status,omitempty"`
+	FinalisedChanged   bool                  `json:"finalisedChanged,omitempty"`
+	BalanceDelta       *big.Int              `json:"balanceDelta,omitempty"`
+	BeaconBalanceDelta int64                 `json:"beaconBalanceDelta,omitempty"` // Gwei
+}
+
+// Get the changes to the nodes and minipools from state a to state b
+func DiffNetworkState(a, b *NetworkState) *StateDiff {
+	diff := &StateDiff{
+		FromSlot: a.BeaconSlotNumber,
+		ToSlot:   b.BeaconSlotNumber,
+	}
+
+	// Nodes
+	for i := range b.NodeDetails {
+		newNode := &b.NodeDetails[i]
+		oldNode, exists := a.NodeDetailsByAddress[newNode.NodeAddress]
+		if !exists {
+			diff.AddedNodes = append(diff.AddedNodes, newNode.NodeAddress)
+			continue
+		}
+		nodeDiff := NodeDiff{
+			NodeAddress:            newNode.NodeAddress,
+			RplStakeDelta:          getDelta(oldNode.RplStake, newNode.RplStake),
+			EffectiveRplStakeDelta: getDelta(oldNode.EffectiveRPLStake, newNode.EffectiveRPLStake),
+			EthMatchedDelta:        getDelta(oldNode.EthMatched, newNode.EthMatched),
+			BalanceEthDelta:        getDelta(oldNode.BalanceETH, newNode.BalanceETH),
+			SmoothingPoolChanged:   oldNode.SmoothingPoolRegistrationState != newNode.SmoothingPoolRegistrationState,
+		}
+		if nodeDiff.RplStakeDelta != nil || nodeDiff.EffectiveRplStakeDelta != nil || nodeDiff.EthMatchedDelta != nil || nodeDiff.BalanceEthDelta != nil || nodeDiff.SmoothingPoolChanged {
+			diff.Nodes = append(diff.Nodes, nodeDiff)
+		}
+	}
+	for _, oldNode := range a.NodeDetails {
+		if _, exists := b.NodeDetailsByAddress[oldNode.NodeAddress]; !exists {
+			diff.RemovedNodes = append(diff.RemovedNodes, oldNode.NodeAddress)
+		}
+	}
+
+	// Minipools
+	for i := range b.MinipoolDetails {
+		newMinipool := &b.MinipoolDetails[i]
+		oldMinipool, exists := a.MinipoolDetailsByAddress[newMinipool.MinipoolAddress]
+		if !exists {
+			diff.AddedMinipools = append(diff.AddedMinipools, newMinipool.MinipoolAddress)
+			continue
+		}
+		minipoolDiff := MinipoolDiff{
+			MinipoolAddress:    newMinipool.MinipoolAddress,
+			NodeAddress:        newMinipool.NodeAddress,
+			FinalisedChanged:   oldMinipool.Finalised != newMinipool.Finalised,
+			BalanceDelta:       getDelta(oldMinipool.Balance, newMinipool.Balance),
+			BeaconBalanceDelta: int64(b.ValidatorDetails[newMinipool.Pubkey].Balance) - int64(a.ValidatorDetails[oldMinipool.Pubkey].Balance),
+		}
+		if oldMinipool.Status != newMinipool.Status {
+			previousStatus := oldMinipool.Status
+			status := newMinipool.Status
+			minipoolDiff.PreviousStatus = &previousStatus
+			minipoolDiff.Status = &status
+		}
+		if minipoolDiff.Status != nil || minipoolDiff.FinalisedChanged || minipoolDiff.BalanceDelta != nil || minipoolDiff.BeaconBalanceDelta != 0 {
+			diff.Minipools = append(diff.Minipools, minipoolDiff)
+		}
+	}
+	for _, oldMinipool := range a.MinipoolDetails {
+		if _, exists := b.MinipoolDetailsByAddress[oldMinipool.MinipoolAddress]; !exists {
+			diff.RemovedMinipools = append(diff.RemovedMinipools, oldMinipool.MinipoolAddress)
+		}
+	}
+
+	return diff
+}
+
+// Get the difference between two values, treating nil as zero, or nil if they're the same
+func getDelta(oldValue *big.Int, newValue *big.Int) *big.Int {
+	delta := big.NewInt(0)
+	if newValue != nil {
+		delta.Set(newValue)
+	}
+	if oldValue != nil {
+		delta.Sub(delta, oldValue)
+	}
+	if delta.Sign() == 0 {
+		return nil
+	}
+	return delta
+}
@@ -0,0 +1,115 @@
+package state
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rocket-pool/rocketpool-go/types"
+	rpstate "github.com/rocket-pool/rocketpool-go/utils/state"
+
+	"github.com/rocket-pool/smartnode/shared/services/beacon"
+)
+
+func newTestNode(node byte, rplStake int64) rpstate.NativeNodeDetails {
+	return rpstate.NativeNodeDetails{
+		Exists:      true,
+		NodeAddress: common.BytesToAddress([]byte{0xf0, node}),
+		RplStake:    big.NewInt(rplStake),
+	}
+}
+
+func newDiffTestState(slot uint64, nodes []rpstate.NativeNodeDetails, minipools []rpstate.NativeMinipoolDetails) *NetworkState {
+	s := newNodeScopedState()
+	s.BeaconSlotNumber = slot
+	s.ValidatorDetails = map[types.ValidatorPubkey]beacon.ValidatorStatus{}
+	s.addNodes(nodes, [][]rpstate.NativeMinipoolDetails{minipools})
+	return s
+}
+
+func TestDiffNetworkStateReportsChanges(t *testing.T) {
+	a := newDiffTestState(100,
+		[]rpstate.NativeNodeDetails{newTestNode(1, 1000), newTestNode(2, 500)},
+		[]rpstate.NativeMinipoolDetails{
+			newTestMinipool(1, 1, types.Prelaunch, 100, 0),
+			newTestMinipool(2, 1, types.Staking, 100, 0),
+			newTestMinipool(3, 2, types.Staking, 100, 0),
+		},
+	)
+	a.ValidatorDetails[a.MinipoolDetails[1].Pubkey] = beacon.ValidatorStatus{Exists: true, Balance: 32e9}
+
+	b := newDiffTestState(200,
+		[]rpstate.NativeNodeDetails{newTestNode(1, 1200), newTestNode(3, 0)},
+		[]rpstate.NativeMinipoolDetails{
+			newTestMinipool(1, 1, types.Staking, 150, 0),
+			newTestMinipool(2, 1, types.Staking, 100, 1e9),
+			newTestMinipool(4, 3, types.Initialized, 200, 0),
+		},
+	)
+	b.ValidatorDetails[b.MinipoolDetails[1].Pubkey] = beacon.ValidatorStatus{Exists: true, Balance: 32e9 + 5}
+
+	diff := DiffNetworkState(a, b)
+	if diff.FromSlot != 100 || diff.ToSlot != 200 {
+		t.Fatalf("expected the diff to go from slot 100 to 200, but got %d to %d", diff.FromSlot, diff.ToSlot)
+	}
+
+	// Nodes
+	if len(diff.AddedNodes) != 1 || diff.AddedNodes[0] != b.NodeDetails[1].NodeAddress {
+		t.Fatalf("expected node 3 to be added, but got %v", diff.AddedNodes)
+	}
+	if len(diff.RemovedNodes) != 1 || diff.RemovedNodes[0] != a.NodeDetails[1].NodeAddress {
+		t.Fatalf("expected node 2 to be removed, but got %v", diff.RemovedNodes)
+	}
+	if len(diff.Nodes) != 1 {
+		t.Fatalf("expected 1 changed node but got %d", len(diff.Nodes))
+	}
+	if diff.Nodes[0].RplStakeDelta.Cmp(big.NewInt(200)) != 0 {
+		t.Fatalf("expected an RPL stake delta of 200 but got %s", diff.Nodes[0].RplStakeDelta)
+	}
+	if diff.Nodes[0].EthMatchedDelta != nil {
+		t.Fatalf("an unchanged field has a delta of %s", diff.Nodes[0].EthMatchedDelta)
+	}
+
+	// Minipools
+	if len(diff.AddedMinipools) != 1 || diff.AddedMinipools[0] != b.MinipoolDetails[2].MinipoolAddress {
+		t.Fatalf("expected minipool 4 to be added, but got %v", diff.AddedMinipools)
+	}
+	if len(diff.RemovedMinipools) != 1 || diff.RemovedMinipools[0] != a.MinipoolDetails[2].MinipoolAddress {
+		t.Fatalf("expected minipool 3 to be removed, but got %v", diff.RemovedMinipools)
+	}
+	if len(diff.Minipools) != 2 {
+		t.Fatalf("expected 2 changed minipools but got %d", len(diff.Minipools))
+	}
+	staked := diff.Minipools[0]
+	if staked.PreviousStatus == nil || *staked.PreviousStatus != types.Prelaunch || staked.Status == nil || *staked.Status != types.Staking {
+		t.Fatalf("expected minipool 1 to move from prelaunch to staking, but got %+v", staked)
+	}
+	rewarded := diff.Minipools[1]
+	if rewarded.Status != nil {
+		t.Fatalf("minipool 2's status didn't change but it has a status change to %s", rewarded.Status)
+	}
+	if rewarded.BalanceDelta.Cmp(big.NewInt(1e9)) != 0 || rewarded.BeaconBalanceDelta != 5 {
+		t.Fatalf("expected minipool 2 to have balance deltas of 1e9 wei and 5 gwei, but got %+v", rewarded)
+	}
+}
+
+func TestDiffOfIdenticalStatesIsEmpty(t *testing.T) {
+	build := func() *NetworkState {
+		return newDiffTestState(100,
+			[]rpstate.NativeNodeDetails{newTestNode(1, 1000)},
+			[]rpstate.NativeMinipoolDetails{newTestMinipool(1, 1, types.Staking, 100, 0)},
+		)
+	}
+	diff := DiffNetworkState(build(), build())
+
+	// Unchanged nodes and minipools are left out entirely, so the serialized diff is just the slots
+	bytes, err := json.Marshal(diff)
+	if err != nil {
+		t.Fatalf("error serializing the diff: %s", err.Error())
+	}
+	expected := `{"fromSlot":100,"toSlot":100}`
+	if string(bytes) != expected {
+		t.Fatalf("expected %s but got %s", expected, string(bytes))
+	}
+}
@@ -0,0 +1,65 @@
+package state
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rocket-pool/rocketpool-go/rocketpool"
+	ens "github.com/wealdtech/go-ens/v3"
+)
+
+// How long a resolved ENS name is cached for
+const ensCacheDuration = 5 * time.Minute
+
+// A cached ENS resolution
+type ensCacheEntry struct {
+	address common.Address
+	expiry  time.Time
+}
+
+// An Execution client that makes its contract calls with a fixed context, since the ENS library doesn't take one
+type contextExecutionClient struct {
+	rocketpool.ExecutionClient
+	ctx context.Context
+}
+
+func (c *contextExecutionClient) CodeAt(_ context.Context, contract common.Address, blockNumber *big.Int) ([]byte, error) {
+	return c.ExecutionClient.CodeAt(c.ctx, contract, blockNumber)
+}
+
+func (c *contextExecutionClient) CallContract(_ context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	return c.ExecutionClient.CallContract(c.ctx, call, blockNumber)
+}
+
+// Resolve an ENS name to an address, using the cache if the name was resolved recently.
+// The cache is only locked while it's read and updated, so a slow resolution doesn't hold up other names.
+func (m *NetworkStateManager) resolveEnsName(ctx context.Context, ensName string) (common.Address, error) {
+	// Check the cache
+	m.ensCacheLock.Lock()
+	entry, exists := m.ensCache[ensName]
+	m.ensCacheLock.Unlock()
+	if exists && time.Now().Before(entry.expiry) {
+		return entry.address, nil
+	}
+
+	// Resolve the name
+	address, err := ens.Resolve(&contextExecutionClient{ExecutionClient: m.ec, ctx: ctx}, ensName)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("error resolving ENS name [%s]: %w", ensName, err)
+	}
+	if address == (common.Address{}) {
+		return common.Address{}, fmt.Errorf("ENS name [%s] does not resolve to an address", ensName)
+	}
+
+	m.ensCacheLock.Lock()
+	m.ensCache[ensName] = ensCacheEntry{
+		address: address,
+		expiry:  time.Now().Add(ensCacheDuration),
+	}
+	m.ensCacheLock.Unlock()
+	return address, nil
+}
@@ -0,0 +1,93 @@
+package state
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rocket-pool/rocketpool-go/rocketpool"
+)
+
+// An Execution client whose contract calls block until they're released or their context is cancelled
+type blockingCallClient struct {
+	rocketpool.ExecutionClient
+	calling chan struct{}
+	release chan struct{}
+}
+
+func (c *blockingCallClient) CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	select {
+	case c.calling <- struct{}{}:
+	default:
+	}
+	select {
+	case <-c.release:
+		return nil, ethereum.NotFound
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func (c *blockingCallClient) CodeAt(ctx context.Context, contract common.Address, blockNumber *big.Int) ([]byte, error) {
+	return nil, ctx.Err()
+}
+
+func newEnsTestManager(ec rocketpool.ExecutionClient) *NetworkStateManager {
+	return &NetworkStateManager{
+		ec:       ec,
+		ensCache: map[string]ensCacheEntry{},
+	}
+}
+
+func TestEnsResolutionIsCancelledWithItsContext(t *testing.T) {
+	ec := &blockingCallClient{calling: make(chan struct{}, 1), release: make(chan struct{})}
+	m := newEnsTestManager(ec)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if _, err := m.resolveEnsName(ctx, "node.eth"); err == nil {
+		t.Fatal("expected the resolution to fail once its context was cancelled")
+	}
+	if _, exists := m.ensCache["node.eth"]; exists {
+		t.Fatal("a failed resolution was cached")
+	}
+}
+
+func TestEnsCacheIsNotLockedDuringResolution(t *testing.T) {
+	ec := &blockingCallClient{calling: make(chan struct{}, 1), release: make(chan struct{})}
+	m := newEnsTestManager(ec)
+	cachedAddress := common.HexToAddress("0x01")
+	m.ensCache["cached.eth"] = ensCacheEntry{
+		address: cachedAddress,
+		expiry:  time.Now().Add(time.Minute),
+	}
+
+	// Start a slow resolution and wait for it to reach the Execution client
+	done := make(chan struct{})
+	go func() {
+		_, _ = m.resolveEnsName(context.Background(), "slow.eth")
+		close(done)
+	}()
+	<-ec.calling
+
+	// A cached name should still resolve while the slow one is in progress
+	result := make(chan common.Address, 1)
+	go func() {
+		address, _ := m.resolveEnsName(context.Background(), "cached.eth")
+		result <- address
+	}()
+	select {
+	case address := <-result:
+		if address != cachedAddress {
+			t.Fatalf("expected the cached address %s but got %s", cachedAddress.Hex(), address.Hex())
+		}
+	case <-time.After(time.Second):
+		t.Fatal("the cached name was blocked by another name's resolution")
+	}
+
+	close(ec.release)
+	<-done
+}
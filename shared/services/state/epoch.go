@@ -0,0 +1,51 @@
+package state
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// A source of the current time, so the epoch timing can be tested without waiting on the real clock
+type clock interface {
+	Now() time.Time
+	After(d time.Duration) <-chan time.Time
+}
+
+// The real system clock
+type systemClock struct{}
+
+func (systemClock) Now() time.Time {
+	return time.Now()
+}
+
+func (systemClock) After(d time.Duration) <-chan time.Time {
+	return time.After(d)
+}
+
+// Sleep until the start of the next Beacon epoch and return it; returns the context's error if it's cancelled first
+func (m *NetworkStateManager) WaitForNextEpoch(ctx context.Context) (uint64, error) {
+	beaconConfig, err := m.getBeaconConfig()
+	if err != nil {
+		return 0, fmt.Errorf("error getting Beacon config: %w", err)
+	}
+
+	// Get the next epoch from the current slot; before genesis, that's epoch 0
+	genesisTime := time.Unix(int64(beaconConfig.GenesisTime), 0)
+	epochDuration := time.Duration(beaconConfig.SlotsPerEpoch*beaconConfig.SecondsPerSlot) * time.Second
+	now := m.clock.Now()
+	nextEpoch := uint64(0)
+	if !now.Before(genesisTime) {
+		currentSlot := uint64(now.Sub(genesisTime).Seconds()) / beaconConfig.SecondsPerSlot
+		nextEpoch = currentSlot/beaconConfig.SlotsPerEpoch + 1
+	}
+	nextEpochTime := genesisTime.Add(time.Duration(nextEpoch) * epochDuration)
+
+	// Wait for it to start
+	select {
+	case <-m.clock.After(nextEpochTime.Sub(now)):
+		return nextEpoch, nil
+	case <-ctx.Done():
+		return 0, ctx.Err()
+	}
+}
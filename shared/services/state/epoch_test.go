@@ -0,0 +1,94 @@
+package state
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/rocket-pool/smartnode/shared/services/beacon"
+)
+
+// A clock that stays at a fixed time until it's asked to wait, then jumps forward by the wait duration
+type fakeClock struct {
+	now    time.Time
+	waited []time.Duration
+	block  bool
+}
+
+func (c *fakeClock) Now() time.Time {
+	return c.now
+}
+
+func (c *fakeClock) After(d time.Duration) <-chan time.Time {
+	c.waited = append(c.waited, d)
+	ch := make(chan time.Time, 1)
+	if !c.block {
+		c.now = c.now.Add(d)
+		ch <- c.now
+	}
+	return ch
+}
+
+func newEpochTestManager(clk *fakeClock) *NetworkStateManager {
+	return &NetworkStateManager{
+		BeaconConfig: beacon.Eth2Config{
+			GenesisTime:    1000,
+			SecondsPerSlot: 12,
+			SlotsPerEpoch:  32,
+		},
+		beaconConfigLoaded: true,
+		clock:              clk,
+	}
+}
+
+func TestWaitForNextEpoch(t *testing.T) {
+	genesis := time.Unix(1000, 0)
+	epochDuration := 384 * time.Second
+
+	tests := []struct {
+		name          string
+		now           time.Time
+		expectedEpoch uint64
+		expectedWait  time.Duration
+	}{
+		{"before genesis", genesis.Add(-10 * time.Second), 0, 10 * time.Second},
+		{"at genesis", genesis, 1, epochDuration},
+		{"mid epoch", genesis.Add(5*epochDuration + 100*time.Second), 6, epochDuration - 100*time.Second},
+		{"last second of epoch", genesis.Add(3*epochDuration - time.Second), 3, time.Second},
+		{"epoch boundary", genesis.Add(4 * epochDuration), 5, epochDuration},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			clk := &fakeClock{now: test.now}
+			m := newEpochTestManager(clk)
+
+			epoch, err := m.WaitForNextEpoch(context.Background())
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+			if epoch != test.expectedEpoch {
+				t.Errorf("expected epoch %d but got %d", test.expectedEpoch, epoch)
+			}
+			if len(clk.waited) != 1 || clk.waited[0] != test.expectedWait {
+				t.Errorf("expected a single wait of %s but got %v", test.expectedWait, clk.waited)
+			}
+			expectedTime := genesis.Add(time.Duration(test.expectedEpoch) * epochDuration)
+			if !clk.now.Equal(expectedTime) {
+				t.Errorf("expected to wake at %s but woke at %s", expectedTime, clk.now)
+			}
+		})
+	}
+}
+
+func TestWaitForNextEpochCancelled(t *testing.T) {
+	clk := &fakeClock{now: time.Unix(2000, 0), block: true}
+	m := newEpochTestManager(clk)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := m.WaitForNextEpoch(ctx)
+	if err != context.Canceled {
+		t.Fatalf("expected %v but got %v", context.Canceled, err)
+	}
+}
@@ -0,0 +1,271 @@
+package state
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/rocket-pool/rocketpool-go/minipool"
+	"github.com/rocket-pool/rocketpool-go/rocketpool"
+	"github.com/rocket-pool/rocketpool-go/utils/multicall"
+	rpstate "github.com/rocket-pool/rocketpool-go/utils/state"
+	"github.com/rocket-pool/smartnode/shared/services/beacon"
+	"github.com/rocket-pool/smartnode/shared/services/config"
+	"github.com/rocket-pool/smartnode/shared/utils/log"
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	minipoolAddressBatchSize     int = 1000
+	minipoolFingerprintBatchSize int = 200
+)
+
+// The subset of a minipool's details that are cheap to query and change whenever any of its other details change.
+// If a minipool's fingerprint is the same in two blocks, its details can be carried forward instead of requeried.
+type minipoolFingerprint struct {
+	StatusBlock         *big.Int
+	Finalised           bool
+	NodeDepositBalance  *big.Int
+	NodeRefundBalance   *big.Int
+	EffectiveDelegate   common.Address
+	UseLatestDelegate   bool
+	PenaltyCount        *big.Int
+	PenaltyRate         *big.Int
+	ReduceBondTime      *big.Int
+	ReduceBondCancelled bool
+	Balance             *big.Int
+}
+
+// Get the fingerprint of a minipool from its full details
+func getMinipoolFingerprint(details *rpstate.NativeMinipoolDetails) minipoolFingerprint {
+	return minipoolFingerprint{
+		StatusBlock:         details.StatusBlock,
+		Finalised:           details.Finalised,
+		NodeDepositBalance:  details.NodeDepositBalance,
+		NodeRefundBalance:   details.NodeRefundBalance,
+		EffectiveDelegate:   details.EffectiveDelegate,
+		UseLatestDelegate:   details.UseLatestDelegate,
+		PenaltyCount:        details.PenaltyCount,
+		PenaltyRate:         details.PenaltyRate,
+		ReduceBondTime:      details.ReduceBondTime,
+		ReduceBondCancelled: details.ReduceBondCancelled,
+		Balance:             details.Balance,
+	}
+}
+
+// Check if two fingerprints describe the same minipool state
+func (f minipoolFingerprint) equals(other minipoolFingerprint) bool {
+	return f.Finalised == other.Finalised &&
+		f.EffectiveDelegate == other.EffectiveDelegate &&
+		f.UseLatestDelegate == other.UseLatestDelegate &&
+		f.ReduceBondCancelled == other.ReduceBondCancelled &&
+		bigEquals(f.StatusBlock, other.StatusBlock) &&
+		bigEquals(f.NodeDepositBalance, other.NodeDepositBalance) &&
+		bigEquals(f.NodeRefundBalance, other.NodeRefundBalance) &&
+		bigEquals(f.PenaltyCount, other.PenaltyCount) &&
+		bigEquals(f.PenaltyRate, other.PenaltyRate) &&
+		bigEquals(f.ReduceBondTime, other.ReduceBondTime) &&
+		bigEquals(f.Balance, other.Balance)
+}
+
+// Updates a previous snapshot of the Rocket Pool network state to the provided Beacon slot.
+// This is the same as building the state from scratch, except that minipools are only requeried if they're new or their
+// fingerprint changed since the previous state; everything else is carried forward.
+func UpdateNetworkState(ctx context.Context, cfg *config.RocketPoolConfig, rp *rocketpool.RocketPool, ec rocketpool.ExecutionClient, bc beacon.Client, log *log.ColorLogger, previousState *NetworkState, slotNumber uint64, beaconConfig beacon.Eth2Config) (*NetworkState, error) {
+	if previousState == nil {
+		return nil, fmt.Errorf("a previous state is required for an incremental update")
+	}
+	if slotNumber < previousState.BeaconSlotNumber {
+		return nil, fmt.Errorf("slot %d is before the previous state's slot %d", slotNumber, previousState.BeaconSlotNumber)
+	}
+
+	return buildNetworkState(ctx, cfg, rp, bc, log, slotNumber, beaconConfig, nil, func(state *NetworkState, contracts *rpstate.NetworkContracts, opts *bind.CallOpts) error {
+		addresses, err := getAllMinipoolAddresses(rp, contracts, opts)
+		if err != nil {
+			return fmt.Errorf("error getting minipool addresses: %w", err)
+		}
+		fingerprints, err := getMinipoolFingerprints(rp, contracts, addresses, previousState.MinipoolDetailsByAddress, opts)
+		if err != nil {
+			return fmt.Errorf("error getting minipool fingerprints: %w", err)
+		}
+
+		var refreshed int
+		state.MinipoolDetails, refreshed, err = mergeMinipoolDetails(ctx, addresses, previousState.MinipoolDetailsByAddress, fingerprints, func(address common.Address) (rpstate.NativeMinipoolDetails, error) {
+			return rpstate.GetNativeMinipoolDetails(rp, contracts, address)
+		})
+		if err != nil {
+			return err
+		}
+		state.logLine("Requeried %d of %d minipools since slot %d", refreshed, len(addresses), previousState.BeaconSlotNumber)
+		return nil
+	})
+}
+
+// Builds the list of minipool details for a new state, carrying forward the details from the previous state for any minipool
+// whose fingerprint hasn't changed and using getDetails to requery the rest. Minipools that no longer exist are dropped.
+// Returns the merged details (in the same order as addresses) and the number of minipools that were requeried.
+// The context is checked before each requery.
+func mergeMinipoolDetails(ctx context.Context, addresses []common.Address, previous map[common.Address]*rpstate.NativeMinipoolDetails, fingerprints map[common.Address]minipoolFingerprint, getDetails func(common.Address) (rpstate.NativeMinipoolDetails, error)) ([]rpstate.NativeMinipoolDetails, int, error) {
+	details := make([]rpstate.NativeMinipoolDetails, len(addresses))
+	refreshed := 0
+	for i, address := range addresses {
+		prev, exists := previous[address]
+		if exists {
+			fingerprint, hasFingerprint := fingerprints[address]
+			if hasFingerprint && fingerprint.equals(getMinipoolFingerprint(prev)) {
+				// Copy the previous details, clearing the shares that depend on the Beacon balance so they get recalculated
+				details[i] = *prev
+				details[i].NodeShareOfBalanceIncludingBeacon = nil
+				details[i].UserShareOfBalanceIncludingBeacon = nil
+				details[i].NodeShareOfBeaconBalance = nil
+				details[i].UserShareOfBeaconBalance = nil
+				continue
+			}
+		}
+
+		if err := ctx.Err(); err != nil {
+			return nil, 0, err
+		}
+		mpd, err := getDetails(address)
+		if err != nil {
+			return nil, 0, fmt.Errorf("error getting details for minipool %s: %w", address.Hex(), err)
+		}
+		details[i] = mpd
+		refreshed++
+	}
+	return details, refreshed, nil
+}
+
+// Get the addresses of all minipools in the network using the multicaller
+func getAllMinipoolAddresses(rp *rocketpool.RocketPool, contracts *rpstate.NetworkContracts, opts *bind.CallOpts) ([]common.Address, error) {
+	minipoolCount, err := minipool.GetMinipoolCount(rp, opts)
+	if err != nil {
+		return nil, fmt.Errorf("error getting minipool count: %w", err)
+	}
+
+	var wg errgroup.Group
+	wg.SetLimit(threadLimit)
+	count := int(minipoolCount)
+	addresses := make([]common.Address, count)
+	for i := 0; i < count; i += minipoolAddressBatchSize {
+		i := i
+		max := i + minipoolAddressBatchSize
+		if max > count {
+			max = count
+		}
+
+		wg.Go(func() error {
+			mc, err := multicall.NewMultiCaller(rp.Client, contracts.Multicaller.ContractAddress)
+			if err != nil {
+				return err
+			}
+			for j := i; j < max; j++ {
+				mc.AddCall(contracts.RocketMinipoolManager, &addresses[j], "getMinipoolAt", big.NewInt(int64(j)))
+			}
+			_, err = mc.FlexibleCall(true, opts)
+			if err != nil {
+				return fmt.Errorf("error executing multicall: %w", err)
+			}
+			return nil
+		})
+	}
+
+	if err := wg.Wait(); err != nil {
+		return nil, err
+	}
+	return addresses, nil
+}
+
+// Get the current fingerprints of the provided minipools that were present in the previous state; new minipools are skipped since they
+// have to be queried in full anyway
+func getMinipoolFingerprints(rp *rocketpool.RocketPool, contracts *rpstate.NetworkContracts, addresses []common.Address, previous map[common.Address]*rpstate.NativeMinipoolDetails, opts *bind.CallOpts) (map[common.Address]minipoolFingerprint, error) {
+	known := make([]*rpstate.NativeMinipoolDetails, 0, len(addresses))
+	knownAddresses := make([]common.Address, 0, len(addresses))
+	for _, address := range addresses {
+		if mpd, exists := previous[address]; exists {
+			known = append(known, mpd)
+			knownAddresses = append(knownAddresses, address)
+		}
+	}
+
+	// Contract balances
+	balances, err := contracts.BalanceBatcher.GetEthBalances(knownAddresses, opts)
+	if err != nil {
+		return nil, fmt.Errorf("error getting minipool balances: %w", err)
+	}
+
+	var wg errgroup.Group
+	wg.SetLimit(threadLimit)
+	count := len(known)
+	fingerprintSlice := make([]minipoolFingerprint, count)
+	for i := 0; i < count; i += minipoolFingerprintBatchSize {
+		i := i
+		max := i + minipoolFingerprintBatchSize
+		if max > count {
+			max = count
+		}
+
+		wg.Go(func() error {
+			mc, err := multicall.NewMultiCaller(rp.Client, contracts.Multicaller.ContractAddress)
+			if err != nil {
+				return err
+			}
+			for j := i; j < max; j++ {
+				mpd := known[j]
+				fingerprint := &fingerprintSlice[j]
+				fingerprint.Balance = balances[j]
+
+				// The version is taken from the previous state; if the delegate was upgraded the effective delegate will change,
+				// which forces a full requery with the new version
+				mp, err := minipool.NewMinipoolFromVersion(rp, mpd.MinipoolAddress, mpd.Version, opts)
+				if err != nil {
+					return fmt.Errorf("error creating binding for minipool %s: %w", mpd.MinipoolAddress.Hex(), err)
+				}
+				mpContract := mp.GetContract()
+				mc.AddCall(mpContract, &fingerprint.StatusBlock, "getStatusBlock")
+				mc.AddCall(mpContract, &fingerprint.Finalised, "getFinalised")
+				mc.AddCall(mpContract, &fingerprint.NodeDepositBalance, "getNodeDepositBalance")
+				mc.AddCall(mpContract, &fingerprint.NodeRefundBalance, "getNodeRefundBalance")
+				mc.AddCall(mpContract, &fingerprint.EffectiveDelegate, "getEffectiveDelegate")
+				mc.AddCall(mpContract, &fingerprint.UseLatestDelegate, "getUseLatestDelegate")
+				if mpd.Version < 3 {
+					fingerprint.ReduceBondTime = big.NewInt(0)
+					fingerprint.ReduceBondCancelled = false
+				} else {
+					mc.AddCall(contracts.RocketMinipoolBondReducer, &fingerprint.ReduceBondTime, "getReduceBondTime", mpd.MinipoolAddress)
+					mc.AddCall(contracts.RocketMinipoolBondReducer, &fingerprint.ReduceBondCancelled, "getReduceBondCancelled", mpd.MinipoolAddress)
+				}
+				penaltyCountKey := crypto.Keccak256Hash([]byte("network.penalties.penalty"), mpd.MinipoolAddress.Bytes())
+				mc.AddCall(contracts.RocketStorage, &fingerprint.PenaltyCount, "getUint", penaltyCountKey)
+				penaltyRateKey := crypto.Keccak256Hash([]byte("minipool.penalty.rate"), mpd.MinipoolAddress.Bytes())
+				mc.AddCall(contracts.RocketStorage, &fingerprint.PenaltyRate, "getUint", penaltyRateKey)
+			}
+			_, err = mc.FlexibleCall(true, opts)
+			if err != nil {
+				return fmt.Errorf("error executing multicall: %w", err)
+			}
+			return nil
+		})
+	}
+
+	if err := wg.Wait(); err != nil {
+		return nil, err
+	}
+
+	fingerprints := make(map[common.Address]minipoolFingerprint, count)
+	for i, address := range knownAddresses {
+		fingerprints[address] = fingerprintSlice[i]
+	}
+	return fingerprints, nil
+}
+
+// Compares two possibly-nil big integers
+func bigEquals(a *big.Int, b *big.Int) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Cmp(b) == 0
+}
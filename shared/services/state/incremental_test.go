@@ -0,0 +1,195 @@
+package state
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"reflect"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rocket-pool/rocketpool-go/types"
+	rpstate "github.com/rocket-pool/rocketpool-go/utils/state"
+)
+
+func newTestMinipool(address byte, node byte, status types.MinipoolStatus, statusBlock int64, balance int64) rpstate.NativeMinipoolDetails {
+	return rpstate.NativeMinipoolDetails{
+		Exists:             true,
+		MinipoolAddress:    common.BytesToAddress([]byte{address}),
+		NodeAddress:        common.BytesToAddress([]byte{0xf0, node}),
+		Pubkey:             types.BytesToValidatorPubkey(append(make([]byte, 47), address)),
+		Status:             status,
+		StatusRaw:          uint8(status),
+		StatusBlock:        big.NewInt(statusBlock),
+		NodeDepositBalance: big.NewInt(8e18),
+		NodeRefundBalance:  big.NewInt(0),
+		PenaltyCount:       big.NewInt(0),
+		PenaltyRate:        big.NewInt(0),
+		ReduceBondTime:     big.NewInt(0),
+		Balance:            big.NewInt(balance),
+		Version:            3,
+	}
+}
+
+// Create a state with the provided minipools and their lookups, the same way a state build does once it has the minipool details
+func newTestMinipoolState(details []rpstate.NativeMinipoolDetails) (*NetworkState, []types.ValidatorPubkey) {
+	state := &NetworkState{
+		MinipoolDetails:          details,
+		MinipoolDetailsByAddress: map[common.Address]*rpstate.NativeMinipoolDetails{},
+		MinipoolDetailsByNode:    map[common.Address][]*rpstate.NativeMinipoolDetails{},
+		NodeDetailsByAddress:     map[common.Address]*rpstate.NativeNodeDetails{},
+	}
+	pubkeys := state.createLookups()
+	return state, pubkeys
+}
+
+func TestIncrementalMatchesFullRebuild(t *testing.T) {
+	// The minipools in state N, with the Beacon shares that state calculated
+	previousDetails := []rpstate.NativeMinipoolDetails{
+		newTestMinipool(1, 1, types.Staking, 100, 0),
+		newTestMinipool(2, 1, types.Prelaunch, 100, 0),
+		newTestMinipool(3, 2, types.Staking, 100, 0),
+		newTestMinipool(4, 2, types.Staking, 100, 0),
+		newTestMinipool(5, 3, types.Withdrawable, 100, 0),
+	}
+	for i := range previousDetails {
+		previousDetails[i].NodeShareOfBeaconBalance = big.NewInt(8e18)
+		previousDetails[i].UserShareOfBeaconBalance = big.NewInt(2.4e18)
+		previousDetails[i].NodeShareOfBalanceIncludingBeacon = big.NewInt(8e18)
+		previousDetails[i].UserShareOfBalanceIncludingBeacon = big.NewInt(2.4e18)
+	}
+	previous, _ := newTestMinipoolState(previousDetails)
+
+	// The minipools as a full build at N+1 sees them before the Beacon shares are calculated: #1 is unchanged, #2 was staked,
+	// #3 got skimmed rewards, #4 was closed, #5 was finalised, and #6 is new
+	finalised := newTestMinipool(5, 3, types.Withdrawable, 100, 0)
+	finalised.Finalised = true
+	fullRebuild := []rpstate.NativeMinipoolDetails{
+		newTestMinipool(1, 1, types.Staking, 100, 0),
+		newTestMinipool(2, 1, types.Staking, 150, 0),
+		newTestMinipool(3, 2, types.Staking, 100, 1e17),
+		finalised,
+		newTestMinipool(6, 3, types.Initialized, 160, 0),
+	}
+	chain := map[common.Address]rpstate.NativeMinipoolDetails{}
+	addresses := []common.Address{}
+	for _, mpd := range fullRebuild {
+		chain[mpd.MinipoolAddress] = mpd
+		addresses = append(addresses, mpd.MinipoolAddress)
+	}
+
+	// Fingerprints are only queried for minipools that were in the previous state
+	fingerprints := map[common.Address]minipoolFingerprint{}
+	for _, address := range addresses {
+		if _, exists := previous.MinipoolDetailsByAddress[address]; exists {
+			mpd := chain[address]
+			fingerprints[address] = getMinipoolFingerprint(&mpd)
+		}
+	}
+
+	requeried := []common.Address{}
+	merged, refreshed, err := mergeMinipoolDetails(context.Background(), addresses, previous.MinipoolDetailsByAddress, fingerprints, func(address common.Address) (rpstate.NativeMinipoolDetails, error) {
+		requeried = append(requeried, address)
+		return chain[address], nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expectedRequeried := []common.Address{addresses[1], addresses[2], addresses[3], addresses[4]}
+	if refreshed != len(expectedRequeried) || !reflect.DeepEqual(requeried, expectedRequeried) {
+		t.Fatalf("expected minipools %v to be requeried but got %v", expectedRequeried, requeried)
+	}
+
+	// The incremental state at N+1 should be the same as the full one, including the lookups and the validators to query
+	incremental, incrementalPubkeys := newTestMinipoolState(merged)
+	full, fullPubkeys := newTestMinipoolState(fullRebuild)
+	if !reflect.DeepEqual(incremental.MinipoolDetails, full.MinipoolDetails) {
+		t.Fatalf("incremental details did not match the full rebuild:\nincremental: %+v\nfull: %+v", incremental.MinipoolDetails, full.MinipoolDetails)
+	}
+	if !reflect.DeepEqual(incremental.MinipoolDetailsByAddress, full.MinipoolDetailsByAddress) {
+		t.Fatal("incremental minipool address lookup did not match the full rebuild")
+	}
+	if !reflect.DeepEqual(incremental.MinipoolDetailsByNode, full.MinipoolDetailsByNode) {
+		t.Fatal("incremental node minipool lookup did not match the full rebuild")
+	}
+	if !reflect.DeepEqual(incrementalPubkeys, fullPubkeys) {
+		t.Fatalf("incremental validators %v did not match the full rebuild's %v", incrementalPubkeys, fullPubkeys)
+	}
+	if _, exists := incremental.MinipoolDetailsByAddress[common.BytesToAddress([]byte{4})]; exists {
+		t.Fatal("closed minipool was carried forward")
+	}
+}
+
+func TestIncrementalStopsWhenCancelled(t *testing.T) {
+	mpd := newTestMinipool(1, 1, types.Staking, 100, 0)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, _, err := mergeMinipoolDetails(ctx, []common.Address{mpd.MinipoolAddress}, map[common.Address]*rpstate.NativeMinipoolDetails{}, map[common.Address]minipoolFingerprint{}, func(address common.Address) (rpstate.NativeMinipoolDetails, error) {
+		t.Fatalf("minipool %s was queried after the context was cancelled", address.Hex())
+		return rpstate.NativeMinipoolDetails{}, nil
+	})
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected a cancellation error but got %v", err)
+	}
+}
+
+func TestIncrementalDoesNotMutatePreviousState(t *testing.T) {
+	mpd := newTestMinipool(1, 1, types.Staking, 100, 0)
+	mpd.NodeShareOfBeaconBalance = big.NewInt(8e18)
+	previous := map[common.Address]*rpstate.NativeMinipoolDetails{
+		mpd.MinipoolAddress: &mpd,
+	}
+	fingerprints := map[common.Address]minipoolFingerprint{
+		mpd.MinipoolAddress: getMinipoolFingerprint(&mpd),
+	}
+
+	merged, _, err := mergeMinipoolDetails(context.Background(), []common.Address{mpd.MinipoolAddress}, previous, fingerprints, func(address common.Address) (rpstate.NativeMinipoolDetails, error) {
+		t.Fatalf("unchanged minipool %s was requeried", address.Hex())
+		return rpstate.NativeMinipoolDetails{}, nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if merged[0].NodeShareOfBeaconBalance != nil {
+		t.Fatal("expected the Beacon shares to be cleared for recalculation")
+	}
+	if mpd.NodeShareOfBeaconBalance == nil {
+		t.Fatal("the previous state's details were modified")
+	}
+}
+
+func TestIncrementalRequeriesOnSingleFieldChanges(t *testing.T) {
+	changes := map[string]func(*rpstate.NativeMinipoolDetails){
+		"reduce bond cancelled": func(mpd *rpstate.NativeMinipoolDetails) { mpd.ReduceBondCancelled = true },
+		"use latest delegate":   func(mpd *rpstate.NativeMinipoolDetails) { mpd.UseLatestDelegate = true },
+		"penalty rate":          func(mpd *rpstate.NativeMinipoolDetails) { mpd.PenaltyRate = big.NewInt(5e17) },
+	}
+	for name, change := range changes {
+		t.Run(name, func(t *testing.T) {
+			mpd := newTestMinipool(1, 1, types.Staking, 100, 0)
+			previous := map[common.Address]*rpstate.NativeMinipoolDetails{
+				mpd.MinipoolAddress: &mpd,
+			}
+			current := mpd
+			change(&current)
+			fingerprints := map[common.Address]minipoolFingerprint{
+				mpd.MinipoolAddress: getMinipoolFingerprint(&current),
+			}
+
+			merged, refreshed, err := mergeMinipoolDetails(context.Background(), []common.Address{mpd.MinipoolAddress}, previous, fingerprints, func(address common.Address) (rpstate.NativeMinipoolDetails, error) {
+				return current, nil
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if refreshed != 1 {
+				t.Fatalf("expected the minipool to be requeried but it was carried forward")
+			}
+			if !reflect.DeepEqual(merged[0], current) {
+				t.Fatalf("expected the requeried details %+v but got %+v", current, merged[0])
+			}
+		})
+	}
+}
@@ -0,0 +1,114 @@
+package state
+
+import (
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"reflect"
+)
+
+var bigIntType = reflect.TypeOf((*big.Int)(nil))
+var jsonMarshalerType = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+var textMarshalerType = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+
+// Write the network, node, and minipool details to the writer as JSON, with big integers as decimal strings.
+// Nodes and minipools are encoded one at a time so mainnet-sized states are never buffered in memory all at once.
+func (s *NetworkState) ToJSON(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+
+	// Header
+	if _, err := fmt.Fprintf(w, "{\"elBlockNumber\":%d,\"beaconSlotNumber\":%d,\"networkDetails\":", s.ElBlockNumber, s.BeaconSlotNumber); err != nil {
+		return err
+	}
+	if err := encoder.Encode(toJsonValue(reflect.ValueOf(s.NetworkDetails))); err != nil {
+		return fmt.Errorf("error encoding network details: %w", err)
+	}
+
+	// Node details
+	if _, err := io.WriteString(w, ",\"nodeDetails\":["); err != nil {
+		return err
+	}
+	for i := range s.NodeDetails {
+		if i > 0 {
+			if _, err := io.WriteString(w, ","); err != nil {
+				return err
+			}
+		}
+		if err := encoder.Encode(toJsonValue(reflect.ValueOf(s.NodeDetails[i]))); err != nil {
+			return fmt.Errorf("error encoding details for node %s: %w", s.NodeDetails[i].NodeAddress.Hex(), err)
+		}
+	}
+
+	// Minipool details
+	if _, err := io.WriteString(w, "],\"minipoolDetails\":["); err != nil {
+		return err
+	}
+	for i := range s.MinipoolDetails {
+		if i > 0 {
+			if _, err := io.WriteString(w, ","); err != nil {
+				return err
+			}
+		}
+		if err := encoder.Encode(toJsonValue(reflect.ValueOf(s.MinipoolDetails[i]))); err != nil {
+			return fmt.Errorf("error encoding details for minipool %s: %w", s.MinipoolDetails[i].MinipoolAddress.Hex(), err)
+		}
+	}
+
+	_, err := io.WriteString(w, "]}\n")
+	return err
+}
+
+// Convert a value into one that encodes the same way, except with big integers as decimal strings.
+// Structs become maps keyed by field name, so their keys are always encoded in a stable order.
+func toJsonValue(value reflect.Value) interface{} {
+	if !value.IsValid() {
+		return nil
+	}
+
+	// Big integers are the reason this exists; they have to be caught before the marshaler check below
+	if value.Type() == bigIntType {
+		if value.IsNil() {
+			return nil
+		}
+		return value.Interface().(*big.Int).String()
+	}
+
+	// Anything that already knows how to encode itself is left alone
+	if value.Type().Implements(jsonMarshalerType) || value.Type().Implements(textMarshalerType) {
+		return value.Interface()
+	}
+
+	switch value.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if value.IsNil() {
+			return nil
+		}
+		return toJsonValue(value.Elem())
+
+	case reflect.Struct:
+		fields := map[string]interface{}{}
+		for i := 0; i < value.NumField(); i++ {
+			field := value.Type().Field(i)
+			if !field.IsExported() {
+				continue
+			}
+			fields[field.Name] = toJsonValue(value.Field(i))
+		}
+		return fields
+
+	case reflect.Slice, reflect.Array:
+		if value.Type().Elem().Kind() == reflect.Uint8 {
+			return value.Interface()
+		}
+		elements := make([]interface{}, value.Len())
+		for i := range elements {
+			elements[i] = toJsonValue(value.Index(i))
+		}
+		return elements
+
+	default:
+		return value.Interface()
+	}
+}
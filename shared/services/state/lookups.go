@@ -0,0 +1,57 @@
+package state
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/rocket-pool/rocketpool-go/types"
+	rpstate "github.com/rocket-pool/rocketpool-go/utils/state"
+)
+
+// Returned when a validator doesn't belong to any minipool in the state
+var ErrValidatorNotFound = errors.New("validator does not belong to a Rocket Pool minipool")
+
+// Get the minipool that owns the validator with the given Beacon index, along with the minipool's node
+func (s *NetworkState) GetMinipoolByValidatorIndex(index uint64) (*rpstate.NativeMinipoolDetails, *rpstate.NativeNodeDetails, error) {
+	s.validatorLookupOnce.Do(s.createValidatorLookups)
+	mpd, exists := s.minipoolDetailsByValidatorIndex[index]
+	if !exists {
+		return nil, nil, ErrValidatorNotFound
+	}
+	return mpd, s.NodeDetailsByAddress[mpd.NodeAddress], nil
+}
+
+// Get the minipool that owns the validator with the given pubkey, along with the minipool's node
+func (s *NetworkState) GetMinipoolByPubkey(pubkey types.ValidatorPubkey) (*rpstate.NativeMinipoolDetails, *rpstate.NativeNodeDetails, error) {
+	s.validatorLookupOnce.Do(s.createValidatorLookups)
+	mpd, exists := s.minipoolDetailsByPubkey[pubkey]
+	if !exists {
+		return nil, nil, ErrValidatorNotFound
+	}
+	return mpd, s.NodeDetailsByAddress[mpd.NodeAddress], nil
+}
+
+// Build the maps of validator pubkeys and indices to minipools
+func (s *NetworkState) createValidatorLookups() {
+	s.minipoolDetailsByPubkey = make(map[types.ValidatorPubkey]*rpstate.NativeMinipoolDetails, len(s.MinipoolDetails))
+	s.minipoolDetailsByValidatorIndex = make(map[uint64]*rpstate.NativeMinipoolDetails, len(s.MinipoolDetails))
+	emptyPubkey := types.ValidatorPubkey{}
+	for i, mpd := range s.MinipoolDetails {
+		if mpd.Pubkey == emptyPubkey {
+			continue
+		}
+		s.minipoolDetailsByPubkey[mpd.Pubkey] = &s.MinipoolDetails[i]
+
+		// Validators that haven't been seen on Beacon yet don't have an index
+		validator, exists := s.ValidatorDetails[mpd.Pubkey]
+		if !exists || !validator.Exists {
+			continue
+		}
+		index, err := strconv.ParseUint(validator.Index, 10, 64)
+		if err != nil {
+			s.logLine("WARNING: minipool %s has an invalid validator index (%s)", mpd.MinipoolAddress.Hex(), validator.Index)
+			continue
+		}
+		s.minipoolDetailsByValidatorIndex[index] = &s.MinipoolDetails[i]
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"math/big"
+	"sync"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -24,12 +25,57 @@ type NetworkStateManager struct {
 	Network      cfgtypes.Network
 	ChainID      uint
 	BeaconConfig beacon.Eth2Config
+
+	// The clients used for the heavy reads of state builds; these are rp and ec unless the owner provides a state read EC
+	readRp *rocketpool.RocketPool
+	readEc rocketpool.ExecutionClient
+
+	// Whether the Beacon config has been retrieved yet
+	beaconConfigLoaded bool
+	beaconConfigLock   sync.Mutex
+
+	// Recently resolved ENS names
+	ensCache     map[string]ensCacheEntry
+	ensCacheLock sync.Mutex
+
+	// The clock used to time epoch boundaries
+	clock clock
 }
 
+// Settings for retrieving the Beacon config when the manager is created
+const (
+	beaconConfigAttempts     int           = 5
+	beaconConfigInitialDelay time.Duration = 2 * time.Second
+)
+
 // Create a new manager for the network state
 func NewNetworkStateManager(rp *rocketpool.RocketPool, cfg *config.RocketPoolConfig, ec rocketpool.ExecutionClient, bc beacon.Client, log *log.ColorLogger) (*NetworkStateManager, error) {
 
 	// Create the manager
+	m := NewLazyNetworkStateManager(rp, cfg, ec, bc, log)
+
+	// Get the Beacon config info, retrying with a backoff in case the Beacon node is still starting
+	delay := beaconConfigInitialDelay
+	for attempt := 1; ; attempt++ {
+		_, err := m.getBeaconConfig()
+		if err == nil {
+			break
+		}
+		if attempt == beaconConfigAttempts {
+			return nil, fmt.Errorf("error getting Beacon config after %d attempts: %w", attempt, err)
+		}
+		m.logLine("Error getting Beacon config (attempt %d of %d): %s; retrying in %s...", attempt, beaconConfigAttempts, err.Error(), delay)
+		time.Sleep(delay)
+		delay *= 2
+	}
+
+	return m, nil
+
+}
+
+// Create a new manager for the network state without retrieving the Beacon config yet.
+// The manager runs in a degraded mode until the config is retrieved on first use, so it can be created while the Beacon node is unavailable.
+func NewLazyNetworkStateManager(rp *rocketpool.RocketPool, cfg *config.RocketPoolConfig, ec rocketpool.ExecutionClient, bc beacon.Client, log *log.ColorLogger) *NetworkStateManager {
 	m := &NetworkStateManager{
 		cfg:     cfg,
 		rp:      rp,
@@ -39,17 +85,37 @@ func NewNetworkStateManager(rp *rocketpool.RocketPool, cfg *config.RocketPoolCon
 		Config:  cfg,
 		Network: cfg.Smartnode.Network.Value.(cfgtypes.Network),
 		ChainID: cfg.Smartnode.GetChainID(),
-	}
 
-	// Get the Beacon config info
-	var err error
-	m.BeaconConfig, err = m.bc.GetEth2Config()
-	if err != nil {
-		return nil, err
+		readRp: rp,
+		readEc: ec,
+
+		ensCache: map[string]ensCacheEntry{},
+		clock:    systemClock{},
 	}
+	return m
+}
 
-	return m, nil
+// Use a separate client for the heavy reads of state builds, so they don't load the primary EC.
+// Sync checks and the head slot still use the clients the manager was created with.
+func (m *NetworkStateManager) UseStateReadClient(rp *rocketpool.RocketPool, ec rocketpool.ExecutionClient) {
+	m.readRp = rp
+	m.readEc = ec
+}
+
+// Get the Beacon config, retrieving it from the Beacon node if it hasn't been retrieved yet
+func (m *NetworkStateManager) getBeaconConfig() (beacon.Eth2Config, error) {
+	m.beaconConfigLock.Lock()
+	defer m.beaconConfigLock.Unlock()
 
+	if !m.beaconConfigLoaded {
+		beaconConfig, err := m.bc.GetEth2Config()
+		if err != nil {
+			return beacon.Eth2Config{}, err
+		}
+		m.BeaconConfig = beaconConfig
+		m.beaconConfigLoaded = true
+	}
+	return m.BeaconConfig, nil
 }
 
 // Get the state of the network using the latest Execution layer block
@@ -70,11 +136,50 @@ func (m *NetworkStateManager) GetHeadStateForNode(nodeAddress common.Address, ca
 	return m.getStateForNode(nodeAddress, targetSlot, calculateTotalEffectiveStake)
 }
 
+// Get the state of the network for several nodes using the latest Execution layer block, along with the total effective RPL stake for the network if requested
+func (m *NetworkStateManager) GetHeadStateForNodes(nodeAddresses []common.Address, calculateTotalEffectiveStake bool) (*NetworkState, *big.Int, error) {
+	targetSlot, err := m.GetHeadSlot()
+	if err != nil {
+		return nil, nil, fmt.Errorf("error getting latest Beacon slot: %w", err)
+	}
+	beaconConfig, err := m.getBeaconConfig()
+	if err != nil {
+		return nil, nil, fmt.Errorf("error getting Beacon config: %w", err)
+	}
+	return CreateNetworkStateForNodes(m.cfg, m.readRp, m.readEc, m.bc, m.log, targetSlot, beaconConfig, nodeAddresses, calculateTotalEffectiveStake)
+}
+
+// Get the state of the network for a single node, identified by its ENS name, using the latest Execution layer block, along with the total effective RPL stake for the network
+func (m *NetworkStateManager) GetHeadStateForNodeName(ctx context.Context, ensName string, calculateTotalEffectiveStake bool) (*NetworkState, *big.Int, error) {
+	nodeAddress, err := m.resolveEnsName(ctx, ensName)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, nil, err
+	}
+	return m.GetHeadStateForNode(nodeAddress, calculateTotalEffectiveStake)
+}
+
 // Get the state of the network at the provided Beacon slot
 func (m *NetworkStateManager) GetStateForSlot(slotNumber uint64) (*NetworkState, error) {
 	return m.getState(slotNumber)
 }
 
+// Update a previous state of the network to the provided Beacon slot, only requerying the minipools that changed since then.
+// The update is abandoned if the context is cancelled.
+func (m *NetworkStateManager) UpdateStateIncremental(ctx context.Context, previousState *NetworkState, newSlot uint64) (*NetworkState, error) {
+	beaconConfig, err := m.getBeaconConfig()
+	if err != nil {
+		return nil, fmt.Errorf("error getting Beacon config: %w", err)
+	}
+	state, err := UpdateNetworkState(ctx, m.cfg, m.readRp, m.readEc, m.bc, m.log, previousState, newSlot, beaconConfig)
+	if err != nil {
+		return nil, err
+	}
+	return state, nil
+}
+
 // Gets the latest valid block
 func (m *NetworkStateManager) GetLatestBeaconBlock() (beacon.BeaconBlock, error) {
 	targetSlot, err := m.GetHeadSlot()
@@ -84,29 +189,55 @@ func (m *NetworkStateManager) GetLatestBeaconBlock() (beacon.BeaconBlock, error)
 	return m.GetLatestProposedBeaconBlock(targetSlot)
 }
 
-// Gets the latest valid finalized block
+// Gets the latest finalized block, which is the checkpoint block of the latest finalized epoch.
+// The Beacon client's finalized block tag is used if it's supported; otherwise the finalized epoch comes from the chain head's
+// finality checkpoints, and its checkpoint block is the latest proposed block at or before the epoch's first slot.
 func (m *NetworkStateManager) GetLatestFinalizedBeaconBlock() (beacon.BeaconBlock, error) {
+	block, exists, err := m.bc.GetBeaconBlock("finalized")
+	if err == nil && exists {
+		return block, nil
+	}
+	if err != nil {
+		m.logLine("Getting the finalized block by tag failed (%s), using the Beacon head's finalized epoch instead...", err.Error())
+	}
+
+	beaconConfig, err := m.getBeaconConfig()
+	if err != nil {
+		return beacon.BeaconBlock{}, fmt.Errorf("error getting Beacon config: %w", err)
+	}
 	head, err := m.bc.GetBeaconHead()
 	if err != nil {
 		return beacon.BeaconBlock{}, fmt.Errorf("error getting Beacon chain head: %w", err)
 	}
-	targetSlot := head.FinalizedEpoch*m.BeaconConfig.SlotsPerEpoch + (m.BeaconConfig.SlotsPerEpoch - 1)
-	return m.GetLatestProposedBeaconBlock(targetSlot)
+	return m.GetLatestProposedBeaconBlock(head.FinalizedEpoch * beaconConfig.SlotsPerEpoch)
 }
 
 // Gets the Beacon slot for the latest execution layer block
 func (m *NetworkStateManager) GetHeadSlot() (uint64, error) {
-	// Get the latest EL block
+	beaconConfig, err := m.getBeaconConfig()
+	if err != nil {
+		return 0, fmt.Errorf("error getting Beacon config: %w", err)
+	}
+
+	// Get the latest EL block, falling back to the Beacon chain head if the EC can't provide it
 	latestBlockHeader, err := m.ec.HeaderByNumber(context.Background(), nil)
 	if err != nil {
-		return 0, fmt.Errorf("error getting latest EL block: %w", err)
+		m.logLine("WARNING: couldn't get the latest EL block (%s), using the Beacon chain head instead.", err.Error())
+		headBlock, exists, bcErr := m.bc.GetBeaconBlock("head")
+		if bcErr != nil {
+			return 0, fmt.Errorf("error getting latest EL block (%s) and Beacon head block: %w", err.Error(), bcErr)
+		}
+		if !exists {
+			return 0, fmt.Errorf("error getting latest EL block (%s) and the Beacon head block was not found", err.Error())
+		}
+		return headBlock.Slot, nil
 	}
 
 	// Get the corresponding Beacon slot based on the timestamp
 	latestBlockTime := time.Unix(int64(latestBlockHeader.Time), 0)
-	genesisTime := time.Unix(int64(m.BeaconConfig.GenesisTime), 0)
+	genesisTime := time.Unix(int64(beaconConfig.GenesisTime), 0)
 	secondsSinceGenesis := uint64(latestBlockTime.Sub(genesisTime).Seconds())
-	targetSlot := secondsSinceGenesis / m.BeaconConfig.SecondsPerSlot
+	targetSlot := secondsSinceGenesis / beaconConfig.SecondsPerSlot
 	return targetSlot, nil
 }
 
@@ -131,7 +262,11 @@ func (m *NetworkStateManager) GetLatestProposedBeaconBlock(targetSlot uint64) (b
 
 // Get the state of the network at the provided Beacon slot
 func (m *NetworkStateManager) getState(slotNumber uint64) (*NetworkState, error) {
-	state, err := CreateNetworkState(m.cfg, m.rp, m.ec, m.bc, m.log, slotNumber, m.BeaconConfig)
+	beaconConfig, err := m.getBeaconConfig()
+	if err != nil {
+		return nil, fmt.Errorf("error getting Beacon config: %w", err)
+	}
+	state, err := CreateNetworkState(m.cfg, m.readRp, m.readEc, m.bc, m.log, slotNumber, beaconConfig)
 	if err != nil {
 		return nil, err
 	}
@@ -140,7 +275,11 @@ func (m *NetworkStateManager) getState(slotNumber uint64) (*NetworkState, error)
 
 // Get the state of the network for a specific node only at the provided Beacon slot
 func (m *NetworkStateManager) getStateForNode(nodeAddress common.Address, slotNumber uint64, calculateTotalEffectiveStake bool) (*NetworkState, *big.Int, error) {
-	state, totalEffectiveStake, err := CreateNetworkStateForNode(m.cfg, m.rp, m.ec, m.bc, m.log, slotNumber, m.BeaconConfig, nodeAddress, calculateTotalEffectiveStake)
+	beaconConfig, err := m.getBeaconConfig()
+	if err != nil {
+		return nil, nil, fmt.Errorf("error getting Beacon config: %w", err)
+	}
+	state, totalEffectiveStake, err := CreateNetworkStateForNode(m.cfg, m.readRp, m.readEc, m.bc, m.log, slotNumber, beaconConfig, nodeAddress, calculateTotalEffectiveStake)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -150,6 +289,6 @@ func (m *NetworkStateManager) getStateForNode(nodeAddress common.Address, slotNu
 // Logs a line if the logger is specified
 func (m *NetworkStateManager) logLine(format string, v ...interface{}) {
 	if m.log != nil {
-		m.log.Printlnf(format, v)
+		m.log.Printlnf(format, v...)
 	}
 }
@@ -0,0 +1,130 @@
+package state
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/rocket-pool/rocketpool-go/rocketpool"
+
+	"github.com/rocket-pool/smartnode/shared/services/beacon"
+)
+
+// An Execution client whose header requests always fail
+type failingHeaderClient struct {
+	rocketpool.ExecutionClient
+}
+
+func (c *failingHeaderClient) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+	return nil, errors.New("connection refused")
+}
+
+// A Beacon client that only knows about its head block
+type headOnlyBeaconClient struct {
+	beacon.Client
+	headSlot uint64
+}
+
+func (c *headOnlyBeaconClient) GetBeaconBlock(blockId string) (beacon.BeaconBlock, bool, error) {
+	if blockId != "head" {
+		return beacon.BeaconBlock{}, false, nil
+	}
+	return beacon.BeaconBlock{Slot: c.headSlot}, true, nil
+}
+
+func TestHeadSlotFallsBackToBeaconHead(t *testing.T) {
+	m := &NetworkStateManager{
+		ec: &failingHeaderClient{},
+		bc: &headOnlyBeaconClient{headSlot: 1234},
+		BeaconConfig: beacon.Eth2Config{
+			GenesisTime:    1000,
+			SecondsPerSlot: 12,
+			SlotsPerEpoch:  32,
+		},
+		beaconConfigLoaded: true,
+	}
+
+	slot, err := m.GetHeadSlot()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if slot != 1234 {
+		t.Errorf("expected the Beacon head slot 1234 but got %d", slot)
+	}
+}
+
+// A Beacon client with a fixed set of proposed slots and a finalized checkpoint
+type finalizedBeaconClient struct {
+	beacon.Client
+	proposedSlots  map[uint64]bool
+	checkpointSlot *uint64
+	finalizedEpoch uint64
+}
+
+func (c *finalizedBeaconClient) GetBeaconBlock(blockId string) (beacon.BeaconBlock, bool, error) {
+	if blockId == "finalized" {
+		if c.checkpointSlot == nil {
+			return beacon.BeaconBlock{}, false, errors.New("unsupported block ID")
+		}
+		return beacon.BeaconBlock{Slot: *c.checkpointSlot}, true, nil
+	}
+	var slot uint64
+	if _, err := fmt.Sscan(blockId, &slot); err != nil {
+		return beacon.BeaconBlock{}, false, err
+	}
+	return beacon.BeaconBlock{Slot: slot}, c.proposedSlots[slot], nil
+}
+
+func (c *finalizedBeaconClient) GetBeaconHead() (beacon.BeaconHead, error) {
+	return beacon.BeaconHead{FinalizedEpoch: c.finalizedEpoch}, nil
+}
+
+func TestLatestFinalizedBlockIsCheckpointBlock(t *testing.T) {
+	// Epoch 100 is finalized, and its checkpoint block is at its first slot (3200) unless that slot was missed
+	proposedSlots := map[uint64]bool{}
+	for slot := uint64(3190); slot < 3240; slot++ {
+		proposedSlots[slot] = true
+	}
+	missedSlots := map[uint64]bool{}
+	for slot := range proposedSlots {
+		missedSlots[slot] = slot != 3200
+	}
+	proposedCheckpoint := uint64(3200)
+	missedCheckpoint := uint64(3199)
+
+	for name, test := range map[string]struct {
+		proposedSlots  map[uint64]bool
+		checkpointSlot *uint64
+		expectedSlot   uint64
+	}{
+		"proposed checkpoint":                   {proposedSlots, &proposedCheckpoint, 3200},
+		"missed checkpoint":                     {missedSlots, &missedCheckpoint, 3199},
+		"unsupported tag":                       {proposedSlots, nil, 3200},
+		"unsupported tag and missed checkpoint": {missedSlots, nil, 3199},
+	} {
+		t.Run(name, func(t *testing.T) {
+			m := &NetworkStateManager{
+				bc: &finalizedBeaconClient{
+					proposedSlots:  test.proposedSlots,
+					checkpointSlot: test.checkpointSlot,
+					finalizedEpoch: 100,
+				},
+				BeaconConfig: beacon.Eth2Config{
+					SlotsPerEpoch: 32,
+				},
+				beaconConfigLoaded: true,
+			}
+
+			block, err := m.GetLatestFinalizedBeaconBlock()
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+			if block.Slot != test.expectedSlot {
+				t.Errorf("expected the checkpoint block of epoch 100 (slot %d) but got slot %d", test.expectedSlot, block.Slot)
+			}
+		})
+	}
+}
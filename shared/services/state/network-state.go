@@ -1,8 +1,10 @@
 package state
 
 import (
+	"context"
 	"fmt"
 	"math/big"
+	"sync"
 	"time"
 
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
@@ -55,11 +57,35 @@ type NetworkState struct {
 	OracleDaoMemberDetails []rpstate.OracleDaoMemberDetails
 
 	// Internal fields
-	log *log.ColorLogger
+	log                             *log.ColorLogger
+	validatorLookupOnce             sync.Once
+	minipoolDetailsByPubkey         map[types.ValidatorPubkey]*rpstate.NativeMinipoolDetails
+	minipoolDetailsByValidatorIndex map[uint64]*rpstate.NativeMinipoolDetails
 }
 
 // Creates a snapshot of the entire Rocket Pool network state, on both the Execution and Consensus layers
 func CreateNetworkState(cfg *config.RocketPoolConfig, rp *rocketpool.RocketPool, ec rocketpool.ExecutionClient, bc beacon.Client, log *log.ColorLogger, slotNumber uint64, beaconConfig beacon.Eth2Config) (*NetworkState, error) {
+	return createNetworkState(cfg, rp, ec, bc, log, slotNumber, beaconConfig, nil)
+}
+
+// Creates a snapshot of the entire Rocket Pool network state, sending an update to the progress channel (if provided) after each step
+func createNetworkState(cfg *config.RocketPoolConfig, rp *rocketpool.RocketPool, ec rocketpool.ExecutionClient, bc beacon.Client, log *log.ColorLogger, slotNumber uint64, beaconConfig beacon.Eth2Config, progress chan<- StateProgress) (*NetworkState, error) {
+	return buildNetworkState(context.Background(), cfg, rp, bc, log, slotNumber, beaconConfig, progress, func(state *NetworkState, contracts *rpstate.NetworkContracts, opts *bind.CallOpts) error {
+		var err error
+		state.MinipoolDetails, err = rpstate.GetAllNativeMinipoolDetails(rp, contracts)
+		if err != nil {
+			return fmt.Errorf("error getting all minipool details: %w", err)
+		}
+		return nil
+	})
+}
+
+// Loads the details of every minipool in the network into a state that's being built
+type minipoolDetailsLoader func(state *NetworkState, contracts *rpstate.NetworkContracts, opts *bind.CallOpts) error
+
+// Builds a snapshot of the entire Rocket Pool network state, using loadMinipools to get the minipool details.
+// Everything else is always queried in full. The context is checked between steps and used for the loader's calls.
+func buildNetworkState(ctx context.Context, cfg *config.RocketPoolConfig, rp *rocketpool.RocketPool, bc beacon.Client, log *log.ColorLogger, slotNumber uint64, beaconConfig beacon.Eth2Config, progress chan<- StateProgress, loadMinipools minipoolDetailsLoader) (*NetworkState, error) {
 	// Get the relevant network contracts
 	multicallerAddress := common.HexToAddress(cfg.Smartnode.GetMulticallAddress())
 	balanceBatcherAddress := common.HexToAddress(cfg.Smartnode.GetBalanceBatcherAddress())
@@ -77,6 +103,7 @@ func CreateNetworkState(cfg *config.RocketPoolConfig, rp *rocketpool.RocketPool,
 	elBlockNumber := beaconBlock.ExecutionBlockNumber
 	opts := &bind.CallOpts{
 		BlockNumber: big.NewInt(0).SetUint64(elBlockNumber),
+		Context:     ctx,
 	}
 
 	// Create the state wrapper
@@ -103,6 +130,10 @@ func CreateNetworkState(cfg *config.RocketPoolConfig, rp *rocketpool.RocketPool,
 		return nil, fmt.Errorf("error getting network details: %w", err)
 	}
 	state.logLine("1/6 - Retrieved network details (%s so far)", time.Since(start))
+	state.sendProgress(progress, 1, 6, "Retrieved network details")
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
 
 	// Node details
 	state.NodeDetails, err = rpstate.GetAllNativeNodeDetails(rp, contracts)
@@ -110,36 +141,24 @@ func CreateNetworkState(cfg *config.RocketPoolConfig, rp *rocketpool.RocketPool,
 		return nil, fmt.Errorf("error getting all node details: %w", err)
 	}
 	state.logLine("2/6 - Retrieved node details (%s so far)", time.Since(start))
+	state.sendProgress(progress, 2, 6, "Retrieved node details")
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
 
 	// Minipool details
-	state.MinipoolDetails, err = rpstate.GetAllNativeMinipoolDetails(rp, contracts)
+	err = loadMinipools(state, contracts, opts)
 	if err != nil {
-		return nil, fmt.Errorf("error getting all minipool details: %w", err)
+		return nil, err
 	}
 	state.logLine("3/6 - Retrieved minipool details (%s so far)", time.Since(start))
-
-	// Create the node lookup
-	for i, details := range state.NodeDetails {
-		state.NodeDetailsByAddress[details.NodeAddress] = &state.NodeDetails[i]
+	state.sendProgress(progress, 3, 6, "Retrieved minipool details")
+	if err := ctx.Err(); err != nil {
+		return nil, err
 	}
 
-	// Create the minipool lookups
-	pubkeys := make([]types.ValidatorPubkey, 0, len(state.MinipoolDetails))
-	emptyPubkey := types.ValidatorPubkey{}
-	for i, details := range state.MinipoolDetails {
-		state.MinipoolDetailsByAddress[details.MinipoolAddress] = &state.MinipoolDetails[i]
-		if details.Pubkey != emptyPubkey {
-			pubkeys = append(pubkeys, details.Pubkey)
-		}
-
-		// The map of nodes to minipools
-		nodeList, exists := state.MinipoolDetailsByNode[details.NodeAddress]
-		if !exists {
-			nodeList = []*rpstate.NativeMinipoolDetails{}
-		}
-		nodeList = append(nodeList, &state.MinipoolDetails[i])
-		state.MinipoolDetailsByNode[details.NodeAddress] = nodeList
-	}
+	// Create the lookups
+	pubkeys := state.createLookups()
 
 	// Calculate avg node fees and distributor shares
 	for _, details := range state.NodeDetails {
@@ -152,6 +171,10 @@ func CreateNetworkState(cfg *config.RocketPoolConfig, rp *rocketpool.RocketPool,
 		return nil, fmt.Errorf("error getting Oracle DAO details: %w", err)
 	}
 	state.logLine("4/6 - Retrieved Oracle DAO details (%s so far)", time.Since(start))
+	state.sendProgress(progress, 4, 6, "Retrieved Oracle DAO details")
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
 
 	// Get the validator stats from Beacon
 	statusMap, err := bc.GetValidatorStatuses(pubkeys, &beacon.ValidatorStatusOptions{
@@ -162,6 +185,10 @@ func CreateNetworkState(cfg *config.RocketPoolConfig, rp *rocketpool.RocketPool,
 	}
 	state.ValidatorDetails = statusMap
 	state.logLine("5/6 - Retrieved validator details (total time: %s)", time.Since(start))
+	state.sendProgress(progress, 5, 6, "Retrieved validator details")
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
 
 	// Get the complete node and user shares
 	mpds := make([]*rpstate.NativeMinipoolDetails, len(state.MinipoolDetails))
@@ -179,8 +206,8 @@ func CreateNetworkState(cfg *config.RocketPoolConfig, rp *rocketpool.RocketPool,
 	if err != nil {
 		return nil, err
 	}
-	state.ValidatorDetails = statusMap
 	state.logLine("6/6 - Calculated complete node and user balance shares (total time: %s)", time.Since(start))
+	state.sendProgress(progress, 6, 6, "Calculated complete node and user balance shares")
 
 	return state, nil
 }
@@ -188,11 +215,21 @@ func CreateNetworkState(cfg *config.RocketPoolConfig, rp *rocketpool.RocketPool,
 // Creates a snapshot of the Rocket Pool network, but only for a single node
 // Also gets the total effective RPL stake of the network for convenience since this is required by several node routines
 func CreateNetworkStateForNode(cfg *config.RocketPoolConfig, rp *rocketpool.RocketPool, ec rocketpool.ExecutionClient, bc beacon.Client, log *log.ColorLogger, slotNumber uint64, beaconConfig beacon.Eth2Config, nodeAddress common.Address, calculateTotalEffectiveStake bool) (*NetworkState, *big.Int, error) {
+	return CreateNetworkStateForNodes(cfg, rp, ec, bc, log, slotNumber, beaconConfig, []common.Address{nodeAddress}, calculateTotalEffectiveStake)
+}
+
+// Creates a snapshot of the Rocket Pool network, but only for the given nodes.
+// This shares the network details and Beacon validator queries across all of the nodes, so it's cheaper than building each node's state separately.
+// Also gets the total effective RPL stake of the network if requested.
+func CreateNetworkStateForNodes(cfg *config.RocketPoolConfig, rp *rocketpool.RocketPool, ec rocketpool.ExecutionClient, bc beacon.Client, log *log.ColorLogger, slotNumber uint64, beaconConfig beacon.Eth2Config, nodeAddresses []common.Address, calculateTotalEffectiveStake bool) (*NetworkState, *big.Int, error) {
 	steps := 5
 	if calculateTotalEffectiveStake {
 		steps++
 	}
 
+	// Ignore duplicate nodes so their details and minipools aren't added to the state twice
+	nodeAddresses = getUniqueNodeAddresses(nodeAddresses)
+
 	// Get the relevant network contracts
 	multicallerAddress := common.HexToAddress(cfg.Smartnode.GetMulticallAddress())
 	balanceBatcherAddress := common.HexToAddress(cfg.Smartnode.GetBalanceBatcherAddress())
@@ -237,43 +274,35 @@ func CreateNetworkStateForNode(cfg *config.RocketPoolConfig, rp *rocketpool.Rock
 	}
 	state.logLine("1/%d - Retrieved network details (%s so far)", steps, time.Since(start))
 
-	// Node details
-	nodeDetails, err := rpstate.GetNativeNodeDetails(rp, contracts, nodeAddress)
-	if err != nil {
-		return nil, nil, fmt.Errorf("error getting node details: %w", err)
+	// Node and minipool details
+	nodeDetails := make([]rpstate.NativeNodeDetails, len(nodeAddresses))
+	minipoolDetails := make([][]rpstate.NativeMinipoolDetails, len(nodeAddresses))
+	var wg errgroup.Group
+	wg.SetLimit(threadLimit)
+	for i, nodeAddress := range nodeAddresses {
+		i := i
+		nodeAddress := nodeAddress
+		wg.Go(func() error {
+			var err error
+			nodeDetails[i], err = rpstate.GetNativeNodeDetails(rp, contracts, nodeAddress)
+			if err != nil {
+				return fmt.Errorf("error getting details for node %s: %w", nodeAddress.Hex(), err)
+			}
+			minipoolDetails[i], err = rpstate.GetNodeNativeMinipoolDetails(rp, contracts, nodeAddress)
+			if err != nil {
+				return fmt.Errorf("error getting minipool details for node %s: %w", nodeAddress.Hex(), err)
+			}
+			return nil
+		})
 	}
-	state.NodeDetails = []rpstate.NativeNodeDetails{nodeDetails}
-	state.logLine("2/%d - Retrieved node details (%s so far)", steps, time.Since(start))
-
-	// Minipool details
-	state.MinipoolDetails, err = rpstate.GetNodeNativeMinipoolDetails(rp, contracts, nodeAddress)
-	if err != nil {
-		return nil, nil, fmt.Errorf("error getting all minipool details: %w", err)
+	if err := wg.Wait(); err != nil {
+		return nil, nil, err
 	}
+	state.logLine("2/%d - Retrieved node details (%s so far)", steps, time.Since(start))
 	state.logLine("3/%d - Retrieved minipool details (%s so far)", steps, time.Since(start))
 
-	// Create the node lookup
-	for i, details := range state.NodeDetails {
-		state.NodeDetailsByAddress[details.NodeAddress] = &state.NodeDetails[i]
-	}
-
-	// Create the minipool lookups
-	pubkeys := make([]types.ValidatorPubkey, 0, len(state.MinipoolDetails))
-	emptyPubkey := types.ValidatorPubkey{}
-	for i, details := range state.MinipoolDetails {
-		state.MinipoolDetailsByAddress[details.MinipoolAddress] = &state.MinipoolDetails[i]
-		if details.Pubkey != emptyPubkey {
-			pubkeys = append(pubkeys, details.Pubkey)
-		}
-
-		// The map of nodes to minipools
-		nodeList, exists := state.MinipoolDetailsByNode[details.NodeAddress]
-		if !exists {
-			nodeList = []*rpstate.NativeMinipoolDetails{}
-		}
-		nodeList = append(nodeList, &state.MinipoolDetails[i])
-		state.MinipoolDetailsByNode[details.NodeAddress] = nodeList
-	}
+	// Create the lookups; the validators of every node's minipools are fetched together below
+	pubkeys := state.addNodes(nodeDetails, minipoolDetails)
 
 	// Calculate avg node fees and distributor shares
 	for _, details := range state.NodeDetails {
@@ -325,6 +354,57 @@ func CreateNetworkStateForNode(cfg *config.RocketPoolConfig, rp *rocketpool.Rock
 	return state, totalEffectiveStake, nil
 }
 
+// Get the provided node addresses without any duplicates, keeping their original order
+func getUniqueNodeAddresses(nodeAddresses []common.Address) []common.Address {
+	uniqueAddresses := make([]common.Address, 0, len(nodeAddresses))
+	seenAddresses := map[common.Address]bool{}
+	for _, nodeAddress := range nodeAddresses {
+		if seenAddresses[nodeAddress] {
+			continue
+		}
+		seenAddresses[nodeAddress] = true
+		uniqueAddresses = append(uniqueAddresses, nodeAddress)
+	}
+	return uniqueAddresses
+}
+
+// Add the details of nodes and their minipools to a new state and create the lookups for them,
+// returning the list of minipool pubkeys to query on Beacon
+func (s *NetworkState) addNodes(nodeDetails []rpstate.NativeNodeDetails, minipoolDetails [][]rpstate.NativeMinipoolDetails) []types.ValidatorPubkey {
+	s.NodeDetails = append(s.NodeDetails, nodeDetails...)
+	for _, details := range minipoolDetails {
+		s.MinipoolDetails = append(s.MinipoolDetails, details...)
+	}
+	return s.createLookups()
+}
+
+// Builds the node and minipool lookups from the state's details, returning the list of minipool pubkeys to query on Beacon
+func (s *NetworkState) createLookups() []types.ValidatorPubkey {
+	// Create the node lookup
+	for i, details := range s.NodeDetails {
+		s.NodeDetailsByAddress[details.NodeAddress] = &s.NodeDetails[i]
+	}
+
+	// Create the minipool lookups
+	pubkeys := make([]types.ValidatorPubkey, 0, len(s.MinipoolDetails))
+	emptyPubkey := types.ValidatorPubkey{}
+	for i, details := range s.MinipoolDetails {
+		s.MinipoolDetailsByAddress[details.MinipoolAddress] = &s.MinipoolDetails[i]
+		if details.Pubkey != emptyPubkey {
+			pubkeys = append(pubkeys, details.Pubkey)
+		}
+
+		// The map of nodes to minipools
+		nodeList, exists := s.MinipoolDetailsByNode[details.NodeAddress]
+		if !exists {
+			nodeList = []*rpstate.NativeMinipoolDetails{}
+		}
+		nodeList = append(nodeList, &s.MinipoolDetails[i])
+		s.MinipoolDetailsByNode[details.NodeAddress] = nodeList
+	}
+	return pubkeys
+}
+
 func (s *NetworkState) GetNodeWeight(eligibleBorrowedEth *big.Int, nodeStake *big.Int) *big.Int {
 	rplPrice := s.NetworkDetails.RplPrice
 
@@ -568,6 +648,89 @@ func (s *NetworkState) CalculateTrueEffectiveStakes(scaleByParticipation bool, a
 
 }
 
+// The nodes in the network, split by whether their RPL stake meets the minimum
+type NodeCollateralPartition struct {
+	AboveMinimum      []common.Address
+	BelowMinimum      []common.Address
+	TotalRplShortfall *big.Int
+}
+
+// Split the nodes into those with at least the minimum RPL stake and those below it, along with the
+// total amount of RPL the under-collateralized nodes would need to stake to reach the minimum
+func (s *NetworkState) GetNodeCollateralPartition() NodeCollateralPartition {
+	partition := NodeCollateralPartition{
+		AboveMinimum:      []common.Address{},
+		BelowMinimum:      []common.Address{},
+		TotalRplShortfall: big.NewInt(0),
+	}
+
+	for _, node := range s.NodeDetails {
+		if node.RplStake.Cmp(node.MinimumRPLStake) >= 0 {
+			partition.AboveMinimum = append(partition.AboveMinimum, node.NodeAddress)
+			continue
+		}
+		partition.BelowMinimum = append(partition.BelowMinimum, node.NodeAddress)
+		shortfall := big.NewInt(0).Sub(node.MinimumRPLStake, node.RplStake)
+		partition.TotalRplShortfall.Add(partition.TotalRplShortfall, shortfall)
+	}
+
+	return partition
+}
+
+// A range of validator effective balances, in gwei, and how many minipool validators fall within it
+type EffectiveBalanceBucket struct {
+	LowerBound uint64 // Inclusive
+	UpperBound uint64 // Exclusive
+	Count      int
+}
+
+// The most buckets an effective balance histogram can have; this allows 1 ETH buckets up to the 2048 ETH max effective balance
+const MaxEffectiveBalanceBuckets uint64 = 2048
+
+// Get a histogram of the effective balances of every minipool's validator, using buckets of the given size in gwei.
+// The buckets are contiguous from zero up to the one containing the largest effective balance, and there can't be more
+// than MaxEffectiveBalanceBuckets of them.
+func (s *NetworkState) GetEffectiveBalanceDistribution(bucketSize uint64) ([]EffectiveBalanceBucket, error) {
+	if bucketSize == 0 {
+		return nil, fmt.Errorf("bucket size must be greater than zero")
+	}
+
+	// Get the effective balance of each minipool's validator
+	balances := []uint64{}
+	maxBalance := uint64(0)
+	for _, mpd := range s.MinipoolDetails {
+		validator, exists := s.ValidatorDetails[mpd.Pubkey]
+		if !exists || !validator.Exists {
+			continue
+		}
+		balances = append(balances, validator.EffectiveBalance)
+		if validator.EffectiveBalance > maxBalance {
+			maxBalance = validator.EffectiveBalance
+		}
+	}
+	if len(balances) == 0 {
+		return []EffectiveBalanceBucket{}, nil
+	}
+
+	// Make sure the bucket size won't create too many buckets
+	bucketCount := maxBalance/bucketSize + 1
+	if bucketCount > MaxEffectiveBalanceBuckets {
+		return nil, fmt.Errorf("bucket size %d gwei would require %d buckets for the largest effective balance (%d gwei), but the maximum is %d", bucketSize, bucketCount, maxBalance, MaxEffectiveBalanceBuckets)
+	}
+
+	// Count the balances in each bucket
+	buckets := make([]EffectiveBalanceBucket, bucketCount)
+	for i := range buckets {
+		buckets[i].LowerBound = uint64(i) * bucketSize
+		buckets[i].UpperBound = buckets[i].LowerBound + bucketSize
+	}
+	for _, balance := range balances {
+		buckets[balance/bucketSize].Count++
+	}
+
+	return buckets, nil
+}
+
 // Logs a line if the logger is specified
 func (s *NetworkState) logLine(format string, v ...interface{}) {
 	if s.log != nil {
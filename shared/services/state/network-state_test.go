@@ -0,0 +1,126 @@
+package state
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rocket-pool/rocketpool-go/types"
+	rpstate "github.com/rocket-pool/rocketpool-go/utils/state"
+
+	"github.com/rocket-pool/smartnode/shared/services/beacon"
+)
+
+func newNodeScopedState() *NetworkState {
+	return &NetworkState{
+		NodeDetailsByAddress:     map[common.Address]*rpstate.NativeNodeDetails{},
+		MinipoolDetailsByAddress: map[common.Address]*rpstate.NativeMinipoolDetails{},
+		MinipoolDetailsByNode:    map[common.Address][]*rpstate.NativeMinipoolDetails{},
+	}
+}
+
+func TestMultiNodeStateMatchesSingleNodeStates(t *testing.T) {
+	nodes := []rpstate.NativeNodeDetails{
+		{Exists: true, NodeAddress: common.BytesToAddress([]byte{0xf0, 1})},
+		{Exists: true, NodeAddress: common.BytesToAddress([]byte{0xf0, 2})},
+	}
+	minipools := [][]rpstate.NativeMinipoolDetails{
+		{
+			newTestMinipool(1, 1, types.Staking, 100, 0),
+			newTestMinipool(2, 1, types.Prelaunch, 100, 0),
+		},
+		{
+			newTestMinipool(3, 2, types.Staking, 100, 0),
+		},
+	}
+
+	// Build the multi-node state and each single-node state
+	multi := newNodeScopedState()
+	multiPubkeys := multi.addNodes(nodes, minipools)
+	singlePubkeys := []types.ValidatorPubkey{}
+	for i, node := range nodes {
+		single := newNodeScopedState()
+		singlePubkeys = append(singlePubkeys, single.addNodes([]rpstate.NativeNodeDetails{node}, [][]rpstate.NativeMinipoolDetails{minipools[i]})...)
+
+		// The node's details and minipools should be the same in both
+		multiNode, exists := multi.NodeDetailsByAddress[node.NodeAddress]
+		if !exists {
+			t.Fatalf("node %s is missing from the multi-node state", node.NodeAddress.Hex())
+		}
+		if multiNode.NodeAddress != single.NodeDetailsByAddress[node.NodeAddress].NodeAddress {
+			t.Fatalf("node %s has different details in the multi-node state", node.NodeAddress.Hex())
+		}
+		multiMinipools := multi.MinipoolDetailsByNode[node.NodeAddress]
+		singleMinipools := single.MinipoolDetailsByNode[node.NodeAddress]
+		if len(multiMinipools) != len(singleMinipools) {
+			t.Fatalf("node %s has %d minipools in the multi-node state but %d in its own state", node.NodeAddress.Hex(), len(multiMinipools), len(singleMinipools))
+		}
+		for j := range singleMinipools {
+			address := singleMinipools[j].MinipoolAddress
+			if multiMinipools[j].MinipoolAddress != address {
+				t.Fatalf("node %s minipool %d is %s in the multi-node state but %s in its own state", node.NodeAddress.Hex(), j, multiMinipools[j].MinipoolAddress.Hex(), address.Hex())
+			}
+			if multi.MinipoolDetailsByAddress[address] != multiMinipools[j] {
+				t.Fatalf("the lookups for minipool %s point to different details", address.Hex())
+			}
+		}
+	}
+
+	// The validators of every node's minipools should be queried together
+	if len(multiPubkeys) != len(singlePubkeys) {
+		t.Fatalf("expected %d pubkeys but got %d", len(singlePubkeys), len(multiPubkeys))
+	}
+	for i := range singlePubkeys {
+		if multiPubkeys[i] != singlePubkeys[i] {
+			t.Fatalf("pubkey %d is %s but should be %s", i, multiPubkeys[i].Hex(), singlePubkeys[i].Hex())
+		}
+	}
+}
+
+func TestDuplicateNodeAddressesAreIgnored(t *testing.T) {
+	first := common.BytesToAddress([]byte{0xf0, 1})
+	second := common.BytesToAddress([]byte{0xf0, 2})
+	addresses := getUniqueNodeAddresses([]common.Address{first, second, first, second, first})
+	if len(addresses) != 2 || addresses[0] != first || addresses[1] != second {
+		t.Fatalf("expected [%s %s], got %v", first.Hex(), second.Hex(), addresses)
+	}
+}
+
+// Create a state with one staking minipool for each of the provided validator effective balances, in gwei
+func newEffectiveBalanceState(balances ...uint64) *NetworkState {
+	state := &NetworkState{
+		ValidatorDetails: map[types.ValidatorPubkey]beacon.ValidatorStatus{},
+	}
+	for i, balance := range balances {
+		mpd := newTestMinipool(byte(i+1), 1, types.Staking, 100, 0)
+		state.MinipoolDetails = append(state.MinipoolDetails, mpd)
+		state.ValidatorDetails[mpd.Pubkey] = beacon.ValidatorStatus{Exists: true, EffectiveBalance: balance}
+	}
+	return state
+}
+
+func TestEffectiveBalanceDistributionBuckets(t *testing.T) {
+	state := newEffectiveBalanceState(31e9, 32e9, 32e9)
+	buckets, err := state.GetEffectiveBalanceDistribution(1e9)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if len(buckets) != 33 {
+		t.Fatalf("expected 33 buckets, got %d", len(buckets))
+	}
+	if buckets[31].Count != 1 || buckets[32].Count != 2 {
+		t.Fatalf("expected 1 validator at 31 ETH and 2 at 32 ETH, got %d and %d", buckets[31].Count, buckets[32].Count)
+	}
+	if buckets[32].LowerBound != 32e9 || buckets[32].UpperBound != 33e9 {
+		t.Fatalf("expected the last bucket to cover [32e9, 33e9), got [%d, %d)", buckets[32].LowerBound, buckets[32].UpperBound)
+	}
+}
+
+func TestEffectiveBalanceDistributionRejectsTooManyBuckets(t *testing.T) {
+	state := newEffectiveBalanceState(32e9)
+	if _, err := state.GetEffectiveBalanceDistribution(1); err == nil {
+		t.Fatalf("expected a 1 gwei bucket size to be rejected")
+	}
+	if _, err := state.GetEffectiveBalanceDistribution(0); err == nil {
+		t.Fatalf("expected a zero bucket size to be rejected")
+	}
+}
@@ -0,0 +1,41 @@
+package state
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rocket-pool/rocketpool-go/utils/eth"
+)
+
+// A minipool's node fees compared to the network's current one; fees are fractions scaled by 1e18
+type MinipoolNodeFees struct {
+	MinipoolAddress common.Address
+	NodeAddress     common.Address
+	CreationFee     *big.Int
+	CurrentFee      *big.Int
+	NetworkFee      *big.Int
+	Spread          *big.Int
+}
+
+// Get the node fee a minipool locked in when it was created, the fee it has now, and the network's current node fee.
+// A bond reduction replaces the minipool's fee with the network fee at the time, so the creation fee of a reduced minipool is
+// the one it had before its last reduction. The spread is the minipool's current fee minus the network fee.
+func (s *NetworkState) GetMinipoolNodeFees(minipoolAddress common.Address) (MinipoolNodeFees, bool) {
+	mpd, exists := s.MinipoolDetailsByAddress[minipoolAddress]
+	if !exists {
+		return MinipoolNodeFees{}, false
+	}
+
+	fees := MinipoolNodeFees{
+		MinipoolAddress: mpd.MinipoolAddress,
+		NodeAddress:     mpd.NodeAddress,
+		CurrentFee:      big.NewInt(0).Set(mpd.NodeFee),
+		NetworkFee:      eth.EthToWei(s.NetworkDetails.NodeFee),
+	}
+	fees.CreationFee = big.NewInt(0).Set(fees.CurrentFee)
+	if mpd.LastBondReductionTime != nil && mpd.LastBondReductionTime.Sign() > 0 && mpd.LastBondReductionPrevNodeFee != nil && mpd.LastBondReductionPrevNodeFee.Sign() > 0 {
+		fees.CreationFee.Set(mpd.LastBondReductionPrevNodeFee)
+	}
+	fees.Spread = big.NewInt(0).Sub(fees.CurrentFee, fees.NetworkFee)
+	return fees, true
+}
@@ -0,0 +1,52 @@
+package state
+
+import (
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rocket-pool/rocketpool-go/types"
+)
+
+// A minipool that has moved past prelaunch but whose validator still isn't on the Beacon chain
+type OrphanedMinipool struct {
+	MinipoolAddress common.Address
+	NodeAddress     common.Address
+	Pubkey          types.ValidatorPubkey
+	Status          types.MinipoolStatus
+	StatusTime      time.Time
+	TimeInStatus    time.Duration
+}
+
+// Get the minipools past prelaunch whose validators have no Beacon index, even though they've been in their current status for
+// at least the provided delay. These usually had a bad deposit, and their node operators need to recover them manually.
+func (s *NetworkState) GetOrphanedMinipools(delay time.Duration) []OrphanedMinipool {
+	genesisTime := time.Unix(int64(s.BeaconConfig.GenesisTime), 0)
+	slotTime := genesisTime.Add(time.Duration(s.BeaconSlotNumber*s.BeaconConfig.SecondsPerSlot) * time.Second)
+
+	orphaned := []OrphanedMinipool{}
+	for _, mpd := range s.MinipoolDetails {
+		if mpd.Finalised || (mpd.Status != types.Staking && mpd.Status != types.Withdrawable) {
+			continue
+		}
+		validator, exists := s.ValidatorDetails[mpd.Pubkey]
+		if exists && validator.Exists && validator.Index != "" {
+			continue
+		}
+
+		// Deposits take a while to be processed by the Beacon chain, so only flag minipools once the delay has passed
+		statusTime := time.Unix(mpd.StatusTime.Int64(), 0)
+		timeInStatus := slotTime.Sub(statusTime)
+		if timeInStatus < delay {
+			continue
+		}
+		orphaned = append(orphaned, OrphanedMinipool{
+			MinipoolAddress: mpd.MinipoolAddress,
+			NodeAddress:     mpd.NodeAddress,
+			Pubkey:          mpd.Pubkey,
+			Status:          mpd.Status,
+			StatusTime:      statusTime,
+			TimeInStatus:    timeInStatus,
+		})
+	}
+	return orphaned
+}
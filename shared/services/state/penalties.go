@@ -0,0 +1,49 @@
+package state
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// The penalties that have been applied to a minipool
+type MinipoolPenalties struct {
+	MinipoolAddress common.Address
+	NodeAddress     common.Address
+	PenaltyCount    uint64
+	PenaltyRate     *big.Int
+}
+
+// Get the number of penalties applied to a minipool, which is 0 if it has never been penalized.
+// The counts are read with the rest of the minipool details when the state is built, so this doesn't query the chain.
+func (s *NetworkState) GetMinipoolPenaltyCount(minipoolAddress common.Address) (uint64, bool) {
+	mpd, exists := s.MinipoolDetailsByAddress[minipoolAddress]
+	if !exists {
+		return 0, false
+	}
+	if mpd.PenaltyCount == nil {
+		return 0, true
+	}
+	return mpd.PenaltyCount.Uint64(), true
+}
+
+// Get the penalties of every minipool in the state that has been penalized at least once
+func (s *NetworkState) PenalizedMinipools() []MinipoolPenalties {
+	penalties := []MinipoolPenalties{}
+	for _, mpd := range s.MinipoolDetails {
+		if mpd.PenaltyCount == nil || mpd.PenaltyCount.Sign() == 0 {
+			continue
+		}
+		penaltyRate := big.NewInt(0)
+		if mpd.PenaltyRate != nil {
+			penaltyRate.Set(mpd.PenaltyRate)
+		}
+		penalties = append(penalties, MinipoolPenalties{
+			MinipoolAddress: mpd.MinipoolAddress,
+			NodeAddress:     mpd.NodeAddress,
+			PenaltyCount:    mpd.PenaltyCount.Uint64(),
+			PenaltyRate:     penaltyRate,
+		})
+	}
+	return penalties
+}
@@ -0,0 +1,55 @@
+package state
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rocket-pool/rocketpool-go/types"
+)
+
+// Slashed validators can't withdraw until this many epochs after they were slashed (EPOCHS_PER_SLASHINGS_VECTOR in the mainnet preset)
+const epochsPerSlashingsVector uint64 = 8192
+
+// The slashing status of a minipool's validator
+type MinipoolSlashing struct {
+	MinipoolAddress common.Address
+	NodeAddress     common.Address
+	Pubkey          types.ValidatorPubkey
+	Slashed         bool
+	SlashedEpoch    uint64
+}
+
+// Get the slashing status of a minipool's validator, based on the Beacon validator details in the state
+func (s *NetworkState) GetMinipoolSlashing(minipoolAddress common.Address) (MinipoolSlashing, bool) {
+	mpd, exists := s.MinipoolDetailsByAddress[minipoolAddress]
+	if !exists {
+		return MinipoolSlashing{}, false
+	}
+
+	slashing := MinipoolSlashing{
+		MinipoolAddress: mpd.MinipoolAddress,
+		NodeAddress:     mpd.NodeAddress,
+		Pubkey:          mpd.Pubkey,
+	}
+	validator, exists := s.ValidatorDetails[mpd.Pubkey]
+	if !exists || !validator.Exists || !validator.Slashed {
+		return slashing, true
+	}
+
+	// The Beacon chain doesn't report the slashing epoch directly, but slashing sets the withdrawable epoch relative to it
+	slashing.Slashed = true
+	if validator.WithdrawableEpoch >= epochsPerSlashingsVector {
+		slashing.SlashedEpoch = validator.WithdrawableEpoch - epochsPerSlashingsVector
+	}
+	return slashing, true
+}
+
+// Get the slashing status of every minipool in the state whose validator has been slashed
+func (s *NetworkState) SlashedMinipools() []MinipoolSlashing {
+	slashings := []MinipoolSlashing{}
+	for _, mpd := range s.MinipoolDetails {
+		slashing, exists := s.GetMinipoolSlashing(mpd.MinipoolAddress)
+		if exists && slashing.Slashed {
+			slashings = append(slashings, slashing)
+		}
+	}
+	return slashings
+}
@@ -0,0 +1,80 @@
+package state
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/rocket-pool/rocketpool-go/rocketpool"
+	rpstate "github.com/rocket-pool/rocketpool-go/utils/state"
+)
+
+// A sample of a node's effective RPL stake at a Beacon slot
+type EffectiveStakeSample struct {
+	Slot  uint64   `json:"slot"`
+	Stake *big.Int `json:"stake"`
+}
+
+// Get a node's effective RPL stake at every interval between the start and end slots (inclusive).
+// Historical state is required for this, so it always uses the Archive EC.
+func (m *NetworkStateManager) GetNodeEffectiveStakeHistory(nodeAddress common.Address, startSlot uint64, endSlot uint64, interval uint64) ([]EffectiveStakeSample, error) {
+	if interval == 0 {
+		return nil, fmt.Errorf("the sample interval must be greater than zero")
+	}
+	if startSlot > endSlot {
+		return nil, fmt.Errorf("the start slot (%d) is after the end slot (%d)", startSlot, endSlot)
+	}
+
+	// Connect to the Archive EC
+	archiveEcUrl := m.cfg.Smartnode.ArchiveECUrl.Value.(string)
+	if archiveEcUrl == "" {
+		return nil, fmt.Errorf("getting a node's effective stake history requires an Archive EC, but one is not specified")
+	}
+	ec, err := ethclient.Dial(archiveEcUrl)
+	if err != nil {
+		return nil, fmt.Errorf("error connecting to archive EC: %w", err)
+	}
+	defer ec.Close()
+	rp, err := rocketpool.NewRocketPool(ec, common.HexToAddress(m.cfg.Smartnode.GetStorageAddress()))
+	if err != nil {
+		return nil, fmt.Errorf("error creating Rocket Pool client connected to archive EC: %w", err)
+	}
+
+	multicallerAddress := common.HexToAddress(m.cfg.Smartnode.GetMulticallAddress())
+	balanceBatcherAddress := common.HexToAddress(m.cfg.Smartnode.GetBalanceBatcherAddress())
+
+	samples := make([]EffectiveStakeSample, 0, (endSlot-startSlot)/interval+1)
+	for slot := startSlot; slot <= endSlot; slot += interval {
+		// Get the EL block for the slot, falling back to the last proposed one if it was missing
+		beaconBlock, err := m.GetLatestProposedBeaconBlock(slot)
+		if err != nil {
+			return nil, err
+		}
+		opts := &bind.CallOpts{
+			BlockNumber: big.NewInt(0).SetUint64(beaconBlock.ExecutionBlockNumber),
+		}
+
+		// Get the node's effective stake at that block
+		contracts, err := rpstate.NewNetworkContracts(rp, multicallerAddress, balanceBatcherAddress, opts)
+		if err != nil {
+			return nil, fmt.Errorf("error getting network contracts for slot %d: %w", slot, err)
+		}
+		nodeDetails, err := rpstate.GetNativeNodeDetails(rp, contracts, nodeAddress)
+		if err != nil {
+			return nil, fmt.Errorf("error getting details for node %s at slot %d: %w", nodeAddress.Hex(), slot, err)
+		}
+		samples = append(samples, EffectiveStakeSample{
+			Slot:  slot,
+			Stake: nodeDetails.EffectiveRPLStake,
+		})
+
+		// Avoid overflowing on the last interval
+		if endSlot-slot < interval {
+			break
+		}
+	}
+
+	return samples, nil
+}
@@ -0,0 +1,70 @@
+package state
+
+import (
+	"github.com/rocket-pool/rocketpool-go/rocketpool"
+
+	"github.com/rocket-pool/smartnode/shared/services/beacon"
+	"github.com/rocket-pool/smartnode/shared/services/config"
+	"github.com/rocket-pool/smartnode/shared/utils/log"
+)
+
+// A progress update from a network state build
+type StateProgress struct {
+	Stage         string
+	Step          int
+	TotalSteps    int
+	Percent       float64
+	NodeCount     int
+	MinipoolCount int
+}
+
+// The result of a network state build that is running in the background
+type NetworkStateFuture struct {
+	done  chan struct{}
+	state *NetworkState
+	err   error
+}
+
+// Get a channel that is closed once the build has finished
+func (f *NetworkStateFuture) Done() <-chan struct{} {
+	return f.done
+}
+
+// Wait for the build to finish and get its result
+func (f *NetworkStateFuture) Wait() (*NetworkState, error) {
+	<-f.done
+	return f.state, f.err
+}
+
+// Creates a snapshot of the entire Rocket Pool network state in the background.
+// Progress updates are sent on the returned channel, which is closed once the build completes; the state itself is delivered by the future.
+func CreateNetworkStateStreaming(cfg *config.RocketPoolConfig, rp *rocketpool.RocketPool, ec rocketpool.ExecutionClient, bc beacon.Client, log *log.ColorLogger, slotNumber uint64, beaconConfig beacon.Eth2Config) (<-chan StateProgress, *NetworkStateFuture) {
+	// Buffer every update so the build never blocks on a slow reader
+	progress := make(chan StateProgress, 6)
+	future := &NetworkStateFuture{
+		done: make(chan struct{}),
+	}
+
+	go func() {
+		future.state, future.err = createNetworkState(cfg, rp, ec, bc, log, slotNumber, beaconConfig, progress)
+		close(progress)
+		close(future.done)
+	}()
+
+	return progress, future
+}
+
+// Sends a progress update if the progress channel is specified
+func (s *NetworkState) sendProgress(progress chan<- StateProgress, step int, totalSteps int, stage string) {
+	if progress == nil {
+		return
+	}
+	progress <- StateProgress{
+		Stage:         stage,
+		Step:          step,
+		TotalSteps:    totalSteps,
+		Percent:       float64(step) / float64(totalSteps) * 100,
+		NodeCount:     len(s.NodeDetails),
+		MinipoolCount: len(s.MinipoolDetails),
+	}
+}
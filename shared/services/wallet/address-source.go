@@ -0,0 +1,43 @@
+package wallet
+
+import (
+	"errors"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Returned when trying to sign something while the wallet is in observer mode
+var ErrObserverMode = errors.New("The Smartnode is in read-only observer mode, so it cannot sign transactions or messages. Please remove the observer node address from the Smartnode settings to use your node wallet.")
+
+// Provides the node account's address in place of the one derived from the wallet's keys
+type NodeAddressSource interface {
+	GetNodeAddress() (common.Address, error)
+}
+
+// A fixed node address supplied by the user, used to observe a node without its keys
+type ObserverAddressSource struct {
+	address common.Address
+}
+
+// Create a new observer address source
+func NewObserverAddressSource(address common.Address) *ObserverAddressSource {
+	return &ObserverAddressSource{
+		address: address,
+	}
+}
+
+// Get the observed node's address
+func (s *ObserverAddressSource) GetNodeAddress() (common.Address, error) {
+	return s.address, nil
+}
+
+// Use the given source for the node address instead of the wallet's keys.
+// While a source is set, the wallet is read-only and will refuse to sign anything.
+func (w *Wallet) SetNodeAddressSource(source NodeAddressSource) {
+	w.nodeAddressSource = source
+}
+
+// Check if the wallet is in read-only observer mode
+func (w *Wallet) IsObserver() bool {
+	return w.nodeAddressSource != nil
+}
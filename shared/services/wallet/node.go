@@ -15,6 +15,17 @@ import (
 // Get the node account
 func (w *Wallet) GetNodeAccount() (accounts.Account, error) {
 
+	// Use the observed node's address if one is set
+	if w.nodeAddressSource != nil {
+		address, err := w.nodeAddressSource.GetNodeAddress()
+		if err != nil {
+			return accounts.Account{}, err
+		}
+		return accounts.Account{
+			Address: address,
+		}, nil
+	}
+
 	// Check wallet is initialized
 	if !w.IsInitialized() {
 		return accounts.Account{}, errors.New("Wallet is not initialized")
@@ -47,6 +58,11 @@ func (w *Wallet) GetNodeAccount() (accounts.Account, error) {
 // Get a transactor for the node account
 func (w *Wallet) GetNodeAccountTransactor() (*bind.TransactOpts, error) {
 
+	// Observer mode can't sign anything
+	if w.IsObserver() {
+		return nil, ErrObserverMode
+	}
+
 	// Check wallet is initialized
 	if !w.IsInitialized() {
 		return nil, errors.New("Wallet is not initialized")
@@ -71,6 +87,11 @@ func (w *Wallet) GetNodeAccountTransactor() (*bind.TransactOpts, error) {
 // Get the node account private key bytes
 func (w *Wallet) GetNodePrivateKeyBytes() ([]byte, error) {
 
+	// Observer mode can't sign anything
+	if w.IsObserver() {
+		return nil, ErrObserverMode
+	}
+
 	// Check wallet is initialized
 	if !w.IsInitialized() {
 		return nil, errors.New("Wallet is not initialized")
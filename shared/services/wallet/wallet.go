@@ -57,6 +57,9 @@ type Wallet struct {
 	// Keystores
 	keystores map[string]keystore.Keystore
 
+	// Overrides the node address when observing a node without its keys
+	nodeAddressSource NodeAddressSource
+
 	// Desired gas price & limit from config
 	maxFee         *big.Int
 	maxPriorityFee *big.Int
@@ -272,6 +275,10 @@ func (w *Wallet) Delete() error {
 
 // Signs a serialized TX using the wallet's private key
 func (w *Wallet) Sign(serializedTx []byte) ([]byte, error) {
+	if w.IsObserver() {
+		return nil, ErrObserverMode
+	}
+
 	// Get private key
 	privateKey, _, err := w.getNodePrivateKey()
 	if err != nil {
@@ -298,15 +305,23 @@ func (w *Wallet) Sign(serializedTx []byte) ([]byte, error) {
 	return signedData, nil
 }
 
-// Signs an arbitrary message using the wallet's private key
-func (w *Wallet) SignMessage(message string) ([]byte, error) {
+// Signs an arbitrary message using the wallet's private key, following EIP-191 (personal_sign).
+// The message is hashed with the "\x19Ethereum Signed Message:\n" prefix and its length, so the signature can never be a
+// valid signature for a transaction. That prefix doesn't separate one application from another though: integrations
+// should put their own domain in the message (e.g. the application name, chain ID, and a nonce or timestamp) so a
+// signature made for one of them can't be replayed to another.
+func (w *Wallet) SignMessage(message []byte) ([]byte, error) {
+	if w.IsObserver() {
+		return nil, ErrObserverMode
+	}
+
 	// Get the wallet's private key
 	privateKey, _, err := w.getNodePrivateKey()
 	if err != nil {
 		return nil, err
 	}
 
-	messageHash := accounts.TextHash([]byte(message))
+	messageHash := accounts.TextHash(message)
 	signedMessage, err := crypto.Sign(messageHash, privateKey)
 	if err != nil {
 		return nil, fmt.Errorf("Error signing message: %w", err)
@@ -1,6 +1,14 @@
 package api
 
+import "github.com/goccy/go-json"
+
 type APIResponse struct {
 	Status string `json:"status"`
 	Error  string `json:"error"`
 }
+
+type BatchResponse struct {
+	Status    string            `json:"status"`
+	Error     string            `json:"error"`
+	Responses []json.RawMessage `json:"responses"`
+}
@@ -10,23 +10,25 @@ import (
 )
 
 type AuctionStatusResponse struct {
-	Status              string   `json:"status"`
-	Error               string   `json:"error"`
-	TotalRPLBalance     *big.Int `json:"totalRPLBalance"`
-	AllottedRPLBalance  *big.Int `json:"allottedRPLBalance"`
-	RemainingRPLBalance *big.Int `json:"remainingRPLBalance"`
-	CanCreateLot        bool     `json:"canCreateLot"`
-	LotCounts           struct {
-		ClaimAvailable       int `json:"claimAvailable"`
-		BiddingAvailable     int `json:"biddingAvailable"`
-		RPLRecoveryAvailable int `json:"rplRecoveryAvailable"`
-	} `json:"lotCounts"`
+	Status              string           `json:"status"`
+	Error               string           `json:"error"`
+	TotalRPLBalance     *big.Int         `json:"totalRPLBalance"`
+	AllottedRPLBalance  *big.Int         `json:"allottedRPLBalance"`
+	RemainingRPLBalance *big.Int         `json:"remainingRPLBalance"`
+	CanCreateLot        bool             `json:"canCreateLot"`
+	LotCounts           AuctionLotCounts `json:"lotCounts"`
+}
+type AuctionLotCounts struct {
+	ClaimAvailable       int `json:"claimAvailable"`
+	BiddingAvailable     int `json:"biddingAvailable"`
+	RPLRecoveryAvailable int `json:"rplRecoveryAvailable"`
 }
 
 type AuctionLotsResponse struct {
-	Status string       `json:"status"`
-	Error  string       `json:"error"`
-	Lots   []LotDetails `json:"lots"`
+	Status    string           `json:"status"`
+	Error     string           `json:"error"`
+	Lots      []LotDetails     `json:"lots"`
+	LotCounts AuctionLotCounts `json:"lotCounts"`
 }
 type LotDetails struct {
 	Details              auction.LotDetails `json:"details"`
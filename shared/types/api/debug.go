@@ -0,0 +1,9 @@
+package api
+
+type DumpStateResponse struct {
+	Status           string `json:"status"`
+	Error            string `json:"error"`
+	Path             string `json:"path"`
+	ElBlockNumber    uint64 `json:"elBlockNumber"`
+	BeaconSlotNumber uint64 `json:"beaconSlotNumber"`
+}
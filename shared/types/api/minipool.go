@@ -18,6 +18,7 @@ type MinipoolStatusResponse struct {
 	Error          string            `json:"error"`
 	Minipools      []MinipoolDetails `json:"minipools"`
 	LatestDelegate common.Address    `json:"latestDelegate"`
+	NetworkNodeFee float64           `json:"networkNodeFee"`
 }
 type MinipoolDetails struct {
 	Address               common.Address         `json:"address"`
@@ -25,6 +26,7 @@ type MinipoolDetails struct {
 	Status                minipool.StatusDetails `json:"status"`
 	DepositType           types.MinipoolDeposit  `json:"depositType"`
 	Node                  minipool.NodeDetails   `json:"node"`
+	CreationNodeFee       float64                `json:"creationNodeFee"`
 	User                  minipool.UserDetails   `json:"user"`
 	Balances              tokens.Balances        `json:"balances"`
 	NodeShareOfETHBalance *big.Int               `json:"nodeShareOfETHBalance"`
@@ -101,6 +103,39 @@ type ExitMinipoolResponse struct {
 	Error  string `json:"error"`
 }
 
+type MinipoolWithdrawalCredentialDetails struct {
+	Address             common.Address        `json:"address"`
+	Pubkey              types.ValidatorPubkey `json:"pubkey"`
+	IsVacant            bool                  `json:"isVacant"`
+	ExpectedCredentials common.Hash           `json:"expectedCredentials"`
+	ActualCredentials   common.Hash           `json:"actualCredentials"`
+	UsesBlsCredentials  bool                  `json:"usesBlsCredentials"`
+	Mismatch            bool                  `json:"mismatch"`
+}
+type GetWithdrawalCredentialMismatchesResponse struct {
+	Status        string                                `json:"status"`
+	Error         string                                `json:"error"`
+	MismatchCount int                                   `json:"mismatchCount"`
+	Details       []MinipoolWithdrawalCredentialDetails `json:"details"`
+}
+
+type MinipoolStatusSummary struct {
+	Address            common.Address        `json:"address"`
+	Pubkey             types.ValidatorPubkey `json:"pubkey"`
+	Status             types.MinipoolStatus  `json:"status"`
+	StatusTime         time.Time             `json:"statusTime"`
+	DepositType        types.MinipoolDeposit `json:"depositType"`
+	NodeFee            *big.Int              `json:"nodeFee"`
+	NodeDepositBalance *big.Int              `json:"nodeDepositBalance"`
+	IsVacant           bool                  `json:"isVacant"`
+	Finalised          bool                  `json:"finalised"`
+}
+type GetNodeMinipoolsByStatusResponse struct {
+	Status    string                  `json:"status"`
+	Error     string                  `json:"error"`
+	Minipools []MinipoolStatusSummary `json:"minipools"`
+}
+
 type CanChangeWithdrawalCredentialsResponse struct {
 	Status    string `json:"status"`
 	Error     string `json:"error"`
@@ -194,6 +229,26 @@ type DistributeBalanceResponse struct {
 	TxHash common.Hash `json:"txHash"`
 }
 
+type MinipoolBatchDistributeDetails struct {
+	MinipoolBalanceDistributionDetails
+	SkipReason string      `json:"skipReason"`
+	TxHash     common.Hash `json:"txHash"`
+	Error      string      `json:"error"`
+}
+type CanDistributeBalancesResponse struct {
+	Status        string                           `json:"status"`
+	Error         string                           `json:"error"`
+	CanDistribute bool                             `json:"canDistribute"`
+	Details       []MinipoolBatchDistributeDetails `json:"details"`
+	GasInfo       rocketpool.GasInfo               `json:"gasInfo"`
+}
+type DistributeBalancesResponse struct {
+	Status   string                           `json:"status"`
+	Error    string                           `json:"error"`
+	Details  []MinipoolBatchDistributeDetails `json:"details"`
+	TxHashes []common.Hash                    `json:"txHashes"`
+}
+
 type CanFinaliseMinipoolResponse struct {
 	Status  string             `json:"status"`
 	Error   string             `json:"error"`
@@ -205,6 +260,49 @@ type FinaliseMinipoolResponse struct {
 	TxHash common.Hash `json:"txHash"`
 }
 
+type MinipoolDelegateDetails struct {
+	Address           common.Address `json:"address"`
+	Delegate          common.Address `json:"delegate"`
+	EffectiveDelegate common.Address `json:"effectiveDelegate"`
+	UseLatestDelegate bool           `json:"useLatestDelegate"`
+	Version           uint8          `json:"version"`
+	Finalised         bool           `json:"finalised"`
+	IsLatest          bool           `json:"isLatest"`
+}
+type MinipoolDelegateVersionsResponse struct {
+	Status                string                    `json:"status"`
+	Error                 string                    `json:"error"`
+	LatestDelegateAddress common.Address            `json:"latestDelegateAddress"`
+	LatestDelegateVersion uint8                     `json:"latestDelegateVersion"`
+	Minipools             []MinipoolDelegateDetails `json:"minipools"`
+	OutdatedCount         int                       `json:"outdatedCount"`
+}
+
+type MinipoolBatchDelegateUpgradeDetails struct {
+	MinipoolDelegateDetails
+	CanUpgrade bool               `json:"canUpgrade"`
+	SkipReason string             `json:"skipReason"`
+	GasInfo    rocketpool.GasInfo `json:"gasInfo"`
+	TxHash     common.Hash        `json:"txHash"`
+	Error      string             `json:"error"`
+}
+type CanUpgradeDelegatesResponse struct {
+	Status                string                                `json:"status"`
+	Error                 string                                `json:"error"`
+	CanUpgrade            bool                                  `json:"canUpgrade"`
+	LatestDelegateAddress common.Address                        `json:"latestDelegateAddress"`
+	LatestDelegateVersion uint8                                 `json:"latestDelegateVersion"`
+	Details               []MinipoolBatchDelegateUpgradeDetails `json:"details"`
+	GasInfo               rocketpool.GasInfo                    `json:"gasInfo"`
+}
+type UpgradeDelegatesResponse struct {
+	Status                string                                `json:"status"`
+	Error                 string                                `json:"error"`
+	LatestDelegateAddress common.Address                        `json:"latestDelegateAddress"`
+	Details               []MinipoolBatchDelegateUpgradeDetails `json:"details"`
+	TxHashes              []common.Hash                         `json:"txHashes"`
+}
+
 type CanDelegateUpgradeResponse struct {
 	Status                string             `json:"status"`
 	Error                 string             `json:"error"`
@@ -246,6 +344,84 @@ type CanStakeMinipoolResponse struct {
 	CanStake bool               `json:"canStake"`
 	GasInfo  rocketpool.GasInfo `json:"gasInfo"`
 }
+type MinipoolStakeTimeResponse struct {
+	Status         string        `json:"status"`
+	Error          string        `json:"error"`
+	ScrubPeriod    time.Duration `json:"scrubPeriod"`
+	StakeTime      time.Time     `json:"stakeTime"`
+	TimeUntilStake time.Duration `json:"timeUntilStake"`
+	CanStakeNow    bool          `json:"canStakeNow"`
+}
+type MinipoolCollateralSimulationResult struct {
+	RplPrice        *big.Int `json:"rplPrice"`
+	CollateralRatio float64  `json:"collateralRatio"`
+	MinimumStake    *big.Int `json:"minimumStake"`
+	AboveMinStake   bool     `json:"aboveMinStake"`
+}
+type MinipoolCollateralSimulationResponse struct {
+	Status      string                               `json:"status"`
+	Error       string                               `json:"error"`
+	NodeAddress common.Address                       `json:"nodeAddress"`
+	RplStake    *big.Int                             `json:"rplStake"`
+	BorrowedEth *big.Int                             `json:"borrowedEth"`
+	Results     []MinipoolCollateralSimulationResult `json:"results"`
+}
+type MinipoolNextAction string
+
+const (
+	MinipoolNextAction_None       MinipoolNextAction = "none"
+	MinipoolNextAction_Stake      MinipoolNextAction = "stake"
+	MinipoolNextAction_Promote    MinipoolNextAction = "promote"
+	MinipoolNextAction_ReduceBond MinipoolNextAction = "reduceBond"
+)
+
+type MinipoolNextActionTimeResponse struct {
+	Status          string             `json:"status"`
+	Error           string             `json:"error"`
+	Action          MinipoolNextAction `json:"action"`
+	ActionTime      time.Time          `json:"actionTime"`
+	TimeUntilAction time.Duration      `json:"timeUntilAction"`
+	Deadline        time.Time          `json:"deadline"`
+}
+type MinipoolExitTimingResponse struct {
+	Status                string                `json:"status"`
+	Error                 string                `json:"error"`
+	Address               common.Address        `json:"address"`
+	ValidatorState        beacon.ValidatorState `json:"validatorState"`
+	IsExiting             bool                  `json:"isExiting"`
+	ExitEpoch             uint64                `json:"exitEpoch"`
+	ExitTime              time.Time             `json:"exitTime"`
+	WithdrawableEpoch     uint64                `json:"withdrawableEpoch"`
+	WithdrawableTime      time.Time             `json:"withdrawableTime"`
+	IsWithdrawable        bool                  `json:"isWithdrawable"`
+	TimeUntilWithdrawable time.Duration         `json:"timeUntilWithdrawable"`
+}
+type MinipoolFinalizationDetailsResponse struct {
+	Status           string         `json:"status"`
+	Error            string         `json:"error"`
+	Address          common.Address `json:"address"`
+	WithdrawableSlot uint64         `json:"withdrawableSlot"`
+	ExecutionBlock   uint64         `json:"executionBlock"`
+	BeaconBalance    *big.Int       `json:"beaconBalance"`
+	MinipoolBalance  *big.Int       `json:"minipoolBalance"`
+	TotalBalance     *big.Int       `json:"totalBalance"`
+	Refund           *big.Int       `json:"refund"`
+	NodeShare        *big.Int       `json:"nodeShare"`
+	UserShare        *big.Int       `json:"userShare"`
+}
+type MinipoolAprResponse struct {
+	Status              string         `json:"status"`
+	Error               string         `json:"error"`
+	Address             common.Address `json:"address"`
+	NotActive           bool           `json:"notActive"`
+	StartEpoch          uint64         `json:"startEpoch"`
+	EndEpoch            uint64         `json:"endEpoch"`
+	NodeFee             float64        `json:"nodeFee"`
+	WithdrawnEth        float64        `json:"withdrawnEth"`
+	DistributedInWindow bool           `json:"distributedInWindow"`
+	ConsensusApr        float64        `json:"consensusApr"`
+	TotalApr            float64        `json:"totalApr"`
+}
 type StakeMinipoolResponse struct {
 	Status string      `json:"status"`
 	Error  string      `json:"error"`
@@ -258,6 +434,18 @@ type CanPromoteMinipoolResponse struct {
 	CanPromote bool               `json:"canPromote"`
 	GasInfo    rocketpool.GasInfo `json:"gasInfo"`
 }
+type MinipoolPromoteDetails struct {
+	Address            common.Address `json:"address"`
+	CanPromote         bool           `json:"canPromote"`
+	PromotionTime      time.Time      `json:"promotionTime"`
+	TimeUntilPromotion time.Duration  `json:"timeUntilPromotion"`
+	TimeUntilDissolve  time.Duration  `json:"timeUntilDissolve"`
+}
+type GetMinipoolPromoteDetailsForNodeResponse struct {
+	Status  string                   `json:"status"`
+	Error   string                   `json:"error"`
+	Details []MinipoolPromoteDetails `json:"details"`
+}
 type PromoteMinipoolResponse struct {
 	Status string      `json:"status"`
 	Error  string      `json:"error"`
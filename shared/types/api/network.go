@@ -2,6 +2,7 @@ package api
 
 import (
 	"math/big"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 )
@@ -24,6 +25,19 @@ type RplPriceResponse struct {
 	MinPer16EthMinipoolRplStake *big.Int `json:"minPer16EthMinipoolRplStake"`
 }
 
+type RplStakeTier struct {
+	BondAmount      *big.Int `json:"bondAmount"`
+	BorrowedAmount  *big.Int `json:"borrowedAmount"`
+	MinimumRplStake *big.Int `json:"minimumRplStake"`
+	MaximumRplStake *big.Int `json:"maximumRplStake"`
+}
+type NetworkRplStakeTiersResponse struct {
+	Status   string         `json:"status"`
+	Error    string         `json:"error"`
+	RplPrice *big.Int       `json:"rplPrice"`
+	Tiers    []RplStakeTier `json:"tiers"`
+}
+
 type NetworkStatsResponse struct {
 	Status                    string         `json:"status"`
 	Error                     string         `json:"error"`
@@ -82,6 +96,48 @@ type DownloadRewardsFileResponse struct {
 	Error  string `json:"error"`
 }
 
+type NetworkRewardsTreeNodeEntryResponse struct {
+	Status                 string         `json:"status"`
+	Error                  string         `json:"error"`
+	Interval               uint64         `json:"interval"`
+	NodeAddress            common.Address `json:"nodeAddress"`
+	MerkleRoot             common.Hash    `json:"merkleRoot"`
+	CollateralRplAmount    *big.Int       `json:"collateralRplAmount"`
+	ODaoRplAmount          *big.Int       `json:"oDaoRplAmount"`
+	SmoothingPoolEthAmount *big.Int       `json:"smoothingPoolEthAmount"`
+	MerkleProof            []common.Hash  `json:"merkleProof"`
+}
+
+type NetworkProtocolStatusResponse struct {
+	Status                            string `json:"status"`
+	Error                             string `json:"error"`
+	IsDeployed                        bool   `json:"isDeployed"`
+	IsAtlasDeployed                   bool   `json:"isAtlasDeployed"`
+	DepositEnabled                    bool   `json:"depositEnabled"`
+	AssignDepositsEnabled             bool   `json:"assignDepositsEnabled"`
+	NodeRegistrationEnabled           bool   `json:"nodeRegistrationEnabled"`
+	NodeDepositEnabled                bool   `json:"nodeDepositEnabled"`
+	VacantMinipoolsEnabled            bool   `json:"vacantMinipoolsEnabled"`
+	BondReductionEnabled              bool   `json:"bondReductionEnabled"`
+	MinipoolSubmitWithdrawableEnabled bool   `json:"minipoolSubmitWithdrawableEnabled"`
+	SubmitBalancesEnabled             bool   `json:"submitBalancesEnabled"`
+	SubmitPricesEnabled               bool   `json:"submitPricesEnabled"`
+	CreateLotEnabled                  bool   `json:"createLotEnabled"`
+	BidOnLotEnabled                   bool   `json:"bidOnLotEnabled"`
+}
+
+type SettingDrift struct {
+	Name            string `json:"name"`
+	ConfiguredValue string `json:"configuredValue"`
+	OnChainValue    string `json:"onChainValue"`
+}
+type NetworkSettingsDriftResponse struct {
+	Status          string         `json:"status"`
+	Error           string         `json:"error"`
+	CheckedSettings int            `json:"checkedSettings"`
+	DriftedSettings []SettingDrift `json:"driftedSettings"`
+}
+
 type IsAtlasDeployedResponse struct {
 	Status          string `json:"status"`
 	Error           string `json:"error"`
@@ -93,3 +149,24 @@ type GetLatestDelegateResponse struct {
 	Error   string         `json:"error"`
 	Address common.Address `json:"address"`
 }
+
+type RewardsIntervalInfoResponse struct {
+	Status        string        `json:"status"`
+	Error         string        `json:"error"`
+	Index         uint64        `json:"index"`
+	StartTime     time.Time     `json:"startTime"`
+	StartBlock    uint64        `json:"startBlock"`
+	IntervalTime  time.Duration `json:"intervalTime"`
+	EndTime       time.Time     `json:"endTime"`
+	TimeRemaining time.Duration `json:"timeRemaining"`
+}
+
+type NetworkRplInflationInfoResponse struct {
+	Status                  string        `json:"status"`
+	Error                   string        `json:"error"`
+	InflationIntervalRate   *big.Int      `json:"inflationIntervalRate"`
+	TotalRplSupply          *big.Int      `json:"totalRplSupply"`
+	RewardsInterval         time.Duration `json:"rewardsInterval"`
+	IntervalInflationAmount *big.Int      `json:"intervalInflationAmount"`
+	NextAdjustmentTime      time.Time     `json:"nextAdjustmentTime"`
+}
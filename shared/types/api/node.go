@@ -187,6 +187,15 @@ type GetNodePendingWithdrawalAddressResponse struct {
 	Address common.Address `json:"address"`
 }
 
+type NodeWithdrawalAddressStatusResponse struct {
+	Status               string         `json:"status"`
+	Error                string         `json:"error"`
+	Address              common.Address `json:"address"`
+	PendingAddress       common.Address `json:"pendingAddress"`
+	ConfirmationRequired bool           `json:"confirmationRequired"`
+	NodeCanConfirm       bool           `json:"nodeCanConfirm"`
+}
+
 type CanSetNodeTimezoneResponse struct {
 	Status  string             `json:"status"`
 	Error   string             `json:"error"`
@@ -285,6 +294,28 @@ type NodeWithdrawRplResponse struct {
 	TxHash common.Hash `json:"txHash"`
 }
 
+type MinipoolCreationTransaction struct {
+	Name            string   `json:"name"`
+	GasLimit        uint64   `json:"gasLimit"`
+	Simulated       bool     `json:"simulated"`
+	GasLimitIsRough bool     `json:"gasLimitIsRough"`
+	MaxCost         *big.Int `json:"maxCost"`
+}
+type NodeMinipoolCreationCostResponse struct {
+	Status              string                        `json:"status"`
+	Error               string                        `json:"error"`
+	BondAmount          *big.Int                      `json:"bondAmount"`
+	DepositFromWallet   *big.Int                      `json:"depositFromWallet"`
+	RplPrice            *big.Int                      `json:"rplPrice"`
+	MinimumRplStake     *big.Int                      `json:"minimumRplStake"`
+	RplToStake          *big.Int                      `json:"rplToStake"`
+	MaxFeeWei           *big.Int                      `json:"maxFeeWei"`
+	Transactions        []MinipoolCreationTransaction `json:"transactions"`
+	TotalGasCost        *big.Int                      `json:"totalGasCost"`
+	TotalGasCostIsRough bool                          `json:"totalGasCostIsRough"`
+	TotalEthCost        *big.Int                      `json:"totalEthCost"`
+}
+
 type CanNodeDepositResponse struct {
 	Status                           string             `json:"status"`
 	Error                            string             `json:"error"`
@@ -299,6 +330,9 @@ type CanNodeDepositResponse struct {
 	InvalidAmount                    bool               `json:"invalidAmount"`
 	UnbondedMinipoolsAtMax           bool               `json:"unbondedMinipoolsAtMax"`
 	DepositDisabled                  bool               `json:"depositDisabled"`
+	ActiveMinipoolCount              uint64             `json:"activeMinipoolCount"`
+	MinipoolLimit                    uint64             `json:"minipoolLimit"`
+	MinipoolLimitReached             bool               `json:"minipoolLimitReached"`
 	InConsensus                      bool               `json:"inConsensus"`
 	MinipoolAddress                  common.Address     `json:"minipoolAddress"`
 	GasInfo                          rocketpool.GasInfo `json:"gasInfo"`
@@ -319,6 +353,9 @@ type CanCreateVacantMinipoolResponse struct {
 	InsufficientRplStake bool               `json:"insufficientRplStake"`
 	InvalidAmount        bool               `json:"invalidAmount"`
 	DepositDisabled      bool               `json:"depositDisabled"`
+	ActiveMinipoolCount  uint64             `json:"activeMinipoolCount"`
+	MinipoolLimit        uint64             `json:"minipoolLimit"`
+	MinipoolLimitReached bool               `json:"minipoolLimitReached"`
 	MinipoolAddress      common.Address     `json:"minipoolAddress"`
 	GasInfo              rocketpool.GasInfo `json:"gasInfo"`
 }
@@ -429,6 +466,12 @@ type NodeSignResponse struct {
 	Error      string `json:"error"`
 	SignedData string `json:"signedData"`
 }
+type NodeSignMessageResponse struct {
+	Status        string         `json:"status"`
+	Error         string         `json:"error"`
+	SignedData    string         `json:"signedData"`
+	SignerAddress common.Address `json:"signerAddress"`
+}
 
 type EstimateSetSnapshotDelegateGasResponse struct {
 	Status  string             `json:"status"`
@@ -477,6 +520,14 @@ type NodeCanDistributeResponse struct {
 	NodeShare float64            `json:"nodeShare"`
 	GasInfo   rocketpool.GasInfo `json:"gasInfo"`
 }
+type NodeDistributorBalanceSplitResponse struct {
+	Status         string   `json:"status"`
+	Error          string   `json:"error"`
+	Balance        *big.Int `json:"balance"`
+	AverageNodeFee float64  `json:"averageNodeFee"`
+	NodeShare      *big.Int `json:"nodeShare"`
+	ProtocolShare  *big.Int `json:"protocolShare"`
+}
 type NodeDistributeResponse struct {
 	Status string      `json:"status"`
 	Error  string      `json:"error"`
@@ -502,26 +553,50 @@ type NodeGetRewardsInfoResponse struct {
 	BondedCollateralRatio   float64                `json:"bondedCollateralRatio"`
 }
 
+type UnclaimedRewardsInterval struct {
+	Index     uint64    `json:"index"`
+	StartTime time.Time `json:"startTime"`
+	EndTime   time.Time `json:"endTime"`
+	RplAmount *big.Int  `json:"rplAmount"`
+	EthAmount *big.Int  `json:"ethAmount"`
+}
+type NodeUnclaimedRewardsIntervalsResponse struct {
+	Status           string                     `json:"status"`
+	Error            string                     `json:"error"`
+	Intervals        []UnclaimedRewardsInterval `json:"intervals"`
+	InvalidIntervals []uint64                   `json:"invalidIntervals"`
+	TotalRpl         *big.Int                   `json:"totalRpl"`
+	TotalEth         *big.Int                   `json:"totalEth"`
+}
+
 type CanNodeClaimRewardsResponse struct {
-	Status  string             `json:"status"`
-	Error   string             `json:"error"`
-	GasInfo rocketpool.GasInfo `json:"gasInfo"`
+	Status   string             `json:"status"`
+	Error    string             `json:"error"`
+	TotalRpl *big.Int           `json:"totalRpl"`
+	TotalEth *big.Int           `json:"totalEth"`
+	GasInfo  rocketpool.GasInfo `json:"gasInfo"`
 }
 type NodeClaimRewardsResponse struct {
-	Status string      `json:"status"`
-	Error  string      `json:"error"`
-	TxHash common.Hash `json:"txHash"`
+	Status   string      `json:"status"`
+	Error    string      `json:"error"`
+	TotalRpl *big.Int    `json:"totalRpl"`
+	TotalEth *big.Int    `json:"totalEth"`
+	TxHash   common.Hash `json:"txHash"`
 }
 
 type CanNodeClaimAndStakeRewardsResponse struct {
-	Status  string             `json:"status"`
-	Error   string             `json:"error"`
-	GasInfo rocketpool.GasInfo `json:"gasInfo"`
+	Status   string             `json:"status"`
+	Error    string             `json:"error"`
+	TotalRpl *big.Int           `json:"totalRpl"`
+	TotalEth *big.Int           `json:"totalEth"`
+	GasInfo  rocketpool.GasInfo `json:"gasInfo"`
 }
 type NodeClaimAndStakeRewardsResponse struct {
-	Status string      `json:"status"`
-	Error  string      `json:"error"`
-	TxHash common.Hash `json:"txHash"`
+	Status   string      `json:"status"`
+	Error    string      `json:"error"`
+	TotalRpl *big.Int    `json:"totalRpl"`
+	TotalEth *big.Int    `json:"totalEth"`
+	TxHash   common.Hash `json:"txHash"`
 }
 
 type GetSmoothingPoolRegistrationStatusResponse struct {
@@ -530,6 +605,35 @@ type GetSmoothingPoolRegistrationStatusResponse struct {
 	NodeRegistered          bool          `json:"nodeRegistered"`
 	TimeLeftUntilChangeable time.Duration `json:"timeLeftUntilChangeable"`
 }
+type SmoothingPoolRegistrationChangeTimeResponse struct {
+	Status         string    `json:"status"`
+	Error          string    `json:"error"`
+	LastChangeTime time.Time `json:"lastChangeTime"`
+	NextChangeTime time.Time `json:"nextChangeTime"`
+	CanChangeNow   bool      `json:"canChangeNow"`
+}
+type NodeSmoothingPoolShareResponse struct {
+	Status               string   `json:"status"`
+	Error                string   `json:"error"`
+	OptedOut             bool     `json:"optedOut"`
+	IsEstimate           bool     `json:"isEstimate"`
+	RewardsInterval      uint64   `json:"rewardsInterval"`
+	StartSlot            uint64   `json:"startSlot"`
+	RecordSlot           uint64   `json:"recordSlot"`
+	SmoothingPoolBalance *big.Int `json:"smoothingPoolBalance"`
+	EligibleMinipools    int      `json:"eligibleMinipools"`
+	NodeScore            *big.Int `json:"nodeScore"`
+	EstimatedShare       *big.Int `json:"estimatedShare"`
+}
+type NodeSmoothingPoolStatusResponse struct {
+	Status               string    `json:"status"`
+	Error                string    `json:"error"`
+	OptedOut             bool      `json:"optedOut"`
+	RewardsInterval      uint64    `json:"rewardsInterval"`
+	IntervalStart        time.Time `json:"intervalStart"`
+	SmoothingPoolBalance *big.Int  `json:"smoothingPoolBalance"`
+	EligibleMinipools    int       `json:"eligibleMinipools"`
+}
 type CanSetSmoothingPoolRegistrationStatusResponse struct {
 	Status  string             `json:"status"`
 	Error   string             `json:"error"`
@@ -611,6 +715,52 @@ type NodeEthBalanceResponse struct {
 	Balance *big.Int `json:"balance"`
 }
 
+type NodeMetadataResponse struct {
+	Status                string         `json:"status"`
+	Error                 string         `json:"error"`
+	AccountAddress        common.Address `json:"accountAddress"`
+	TimezoneLocation      string         `json:"timezoneLocation"`
+	RegistrationTime      time.Time      `json:"registrationTime"`
+	FeeDistributorAddress common.Address `json:"feeDistributorAddress"`
+}
+
+type DoppelgangerValidator struct {
+	MinipoolAddress common.Address          `json:"minipoolAddress"`
+	Pubkey          rptypes.ValidatorPubkey `json:"pubkey"`
+	Index           string                  `json:"index"`
+	LiveEpochs      []uint64                `json:"liveEpochs"`
+}
+type NodeDoppelgangerRiskResponse struct {
+	Status           string                  `json:"status"`
+	Error            string                  `json:"error"`
+	Supported        bool                    `json:"supported"`
+	Epochs           []uint64                `json:"epochs"`
+	AtRisk           bool                    `json:"atRisk"`
+	ActiveValidators []DoppelgangerValidator `json:"activeValidators"`
+}
+
+type NodeBalancesResponse struct {
+	Status                       string         `json:"status"`
+	Error                        string         `json:"error"`
+	EthBalance                   *big.Int       `json:"ethBalance"`
+	RplBalance                   *big.Int       `json:"rplBalance"`
+	RplStake                     *big.Int       `json:"rplStake"`
+	WithdrawalAddress            common.Address `json:"withdrawalAddress"`
+	HasSeparateWithdrawalAddress bool           `json:"hasSeparateWithdrawalAddress"`
+	WithdrawalEthBalance         *big.Int       `json:"withdrawalEthBalance"`
+	WithdrawalRplBalance         *big.Int       `json:"withdrawalRplBalance"`
+}
+
+type NodeCollateralRatioTrendResponse struct {
+	Status        string  `json:"status"`
+	Error         string  `json:"error"`
+	PreviousSlot  uint64  `json:"previousSlot"`
+	PreviousBlock uint64  `json:"previousBlock"`
+	PreviousRatio float64 `json:"previousRatio"`
+	CurrentRatio  float64 `json:"currentRatio"`
+	Delta         float64 `json:"delta"`
+}
+
 type NodeAlertsResponse struct {
 	Status string `json:"status"`
 	Error  string `json:"error"`
@@ -2,11 +2,14 @@ package api
 
 import (
 	"math/big"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/rocket-pool/rocketpool-go/dao"
 	tn "github.com/rocket-pool/rocketpool-go/dao/trustednode"
 	"github.com/rocket-pool/rocketpool-go/rocketpool"
+	rptypes "github.com/rocket-pool/rocketpool-go/types"
+	rputils "github.com/rocket-pool/rocketpool-go/utils"
 )
 
 type TNDAOStatusResponse struct {
@@ -35,6 +38,62 @@ type TNDAOMembersResponse struct {
 	Members []tn.MemberDetails `json:"members"`
 }
 
+type TNDAOMemberDetails struct {
+	Address       common.Address `json:"address"`
+	ID            string         `json:"id"`
+	Url           string         `json:"url"`
+	JoinedTime    time.Time      `json:"joinedTime"`
+	RPLBondAmount *big.Int       `json:"rplBondAmount"`
+}
+type TNDAOMemberDetailsResponse struct {
+	Status  string               `json:"status"`
+	Error   string               `json:"error"`
+	Members []TNDAOMemberDetails `json:"members"`
+}
+
+type ScrubQueueMinipool struct {
+	Address               common.Address          `json:"address"`
+	NodeAddress           common.Address          `json:"nodeAddress"`
+	Pubkey                rptypes.ValidatorPubkey `json:"pubkey"`
+	WithdrawalCredentials common.Hash             `json:"withdrawalCredentials"`
+	IsVacant              bool                    `json:"isVacant"`
+	PrelaunchTime         time.Time               `json:"prelaunchTime"`
+	ScrubWindowEnd        time.Time               `json:"scrubWindowEnd"`
+	TimeLeft              time.Duration           `json:"timeLeft"`
+	Deposits              []rputils.DepositData   `json:"deposits"`
+}
+type TNDAOScrubQueueResponse struct {
+	Status               string               `json:"status"`
+	Error                string               `json:"error"`
+	ScrubPeriod          time.Duration        `json:"scrubPeriod"`
+	PromotionScrubPeriod time.Duration        `json:"promotionScrubPeriod"`
+	Minipools            []ScrubQueueMinipool `json:"minipools"`
+}
+
+type TNDAOVoteScrubDetails struct {
+	Address        common.Address     `json:"address"`
+	IsVacant       bool               `json:"isVacant"`
+	ScrubWindowEnd time.Time          `json:"scrubWindowEnd"`
+	CanVote        bool               `json:"canVote"`
+	SkipReason     string             `json:"skipReason"`
+	GasInfo        rocketpool.GasInfo `json:"gasInfo"`
+	TxHash         common.Hash        `json:"txHash"`
+	Error          string             `json:"error"`
+}
+type CanTNDAOVoteScrubResponse struct {
+	Status  string                  `json:"status"`
+	Error   string                  `json:"error"`
+	CanVote bool                    `json:"canVote"`
+	Details []TNDAOVoteScrubDetails `json:"details"`
+	GasInfo rocketpool.GasInfo      `json:"gasInfo"`
+}
+type TNDAOVoteScrubResponse struct {
+	Status   string                  `json:"status"`
+	Error    string                  `json:"error"`
+	Details  []TNDAOVoteScrubDetails `json:"details"`
+	TxHashes []common.Hash           `json:"txHashes"`
+}
+
 type TNDAOProposalsResponse struct {
 	Status    string                `json:"status"`
 	Error     string                `json:"error"`
@@ -139,12 +198,14 @@ type VoteOnTNDAOProposalResponse struct {
 }
 
 type CanExecuteTNDAOProposalResponse struct {
-	Status       string             `json:"status"`
-	Error        string             `json:"error"`
-	CanExecute   bool               `json:"canExecute"`
-	DoesNotExist bool               `json:"doesNotExist"`
-	InvalidState bool               `json:"invalidState"`
-	GasInfo      rocketpool.GasInfo `json:"gasInfo"`
+	Status         string             `json:"status"`
+	Error          string             `json:"error"`
+	CanExecute     bool               `json:"canExecute"`
+	DoesNotExist   bool               `json:"doesNotExist"`
+	TooEarly       bool               `json:"tooEarly"`
+	InvalidState   bool               `json:"invalidState"`
+	ExecutableTime time.Time          `json:"executableTime"`
+	GasInfo        rocketpool.GasInfo `json:"gasInfo"`
 }
 type ExecuteTNDAOProposalResponse struct {
 	Status string      `json:"status"`
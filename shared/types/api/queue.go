@@ -2,6 +2,7 @@ package api
 
 import (
 	"math/big"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/rocket-pool/rocketpool-go/rocketpool"
@@ -15,6 +16,28 @@ type QueueStatusResponse struct {
 	MinipoolQueueCapacity *big.Int `json:"minipoolQueueCapacity"`
 }
 
+type DepositPoolStatusResponse struct {
+	Status          string   `json:"status"`
+	Error           string   `json:"error"`
+	Balance         *big.Int `json:"balance"`
+	ExcessBalance   *big.Int `json:"excessBalance"`
+	Capacity        *big.Int `json:"capacity"`
+	DepositsEnabled bool     `json:"depositsEnabled"`
+}
+
+type MinipoolQueuePositionsResponse struct {
+	Status          string                  `json:"status"`
+	Error           string                  `json:"error"`
+	FillRatePerHour *big.Int                `json:"fillRatePerHour"`
+	EtaAvailable    bool                    `json:"etaAvailable"`
+	Minipools       []MinipoolQueuePosition `json:"minipools"`
+}
+type MinipoolQueuePosition struct {
+	Address           common.Address `json:"address"`
+	Position          uint64         `json:"position"`
+	TimeUntilAssigned time.Duration  `json:"timeUntilAssigned"`
+}
+
 type CanProcessQueueResponse struct {
 	Status                     string             `json:"status"`
 	Error                      string             `json:"error"`
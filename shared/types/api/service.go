@@ -14,13 +14,24 @@ type CreateFeeRecipientFileResponse struct {
 	Distributor common.Address `json:"distributor"`
 }
 
+// The state of an Execution client, classified from its status report
+type EcSyncStatus string
+
+const (
+	EcSyncStatus_Offline EcSyncStatus = "offline"
+	EcSyncStatus_Syncing EcSyncStatus = "syncing"
+	EcSyncStatus_Pruning EcSyncStatus = "pruning"
+	EcSyncStatus_Ready   EcSyncStatus = "ready"
+)
+
 // This is a wrapper for the EC status report
 type ClientStatus struct {
-	IsWorking    bool    `json:"isWorking"`
-	IsSynced     bool    `json:"isSynced"`
-	SyncProgress float64 `json:"syncProgress"`
-	NetworkId    uint    `json:"networkId"`
-	Error        string  `json:"error"`
+	IsWorking    bool         `json:"isWorking"`
+	IsSynced     bool         `json:"isSynced"`
+	SyncProgress float64      `json:"syncProgress"`
+	NetworkId    uint         `json:"networkId"`
+	SyncStatus   EcSyncStatus `json:"syncStatus,omitempty"`
+	Error        string       `json:"error"`
 }
 
 // This is a wrapper for the manager's overall status report
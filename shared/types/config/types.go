@@ -10,6 +10,7 @@ type RewardsMode string
 type MevRelayID string
 type MevSelectionMode string
 type NimbusPruningMode string
+type GasSourceMode string
 
 // Enum to describe which container(s) a parameter impacts, so the Smartnode knows which
 // ones to restart upon a settings change
@@ -107,6 +108,13 @@ const (
 	NimbusPruningMode_Prune   NimbusPruningMode = "prune"
 )
 
+// Enum to describe which sources are used to size the watchtower's gas fees
+const (
+	GasSourceMode_Static                   GasSourceMode = "static"
+	GasSourceMode_ExecutionClient          GasSourceMode = "executionClient"
+	GasSourceMode_ExecutionClientAndOracle GasSourceMode = "executionClientAndOracle"
+)
+
 type Config interface {
 	GetConfigTitle() string
 	GetParameters() []*Parameter
@@ -1,6 +1,7 @@
 package api
 
 import (
+	"errors"
 	"fmt"
 	"math/big"
 	"time"
@@ -13,11 +14,26 @@ import (
 	"github.com/rocket-pool/smartnode/shared/services/config"
 	"github.com/rocket-pool/smartnode/shared/utils/log"
 	"github.com/rocket-pool/smartnode/shared/utils/math"
+	txutils "github.com/rocket-pool/smartnode/shared/utils/tx"
 )
 
 // The fraction of the timeout period to trigger overdue transactions
 const TimeoutSafetyFactor int = 2
 
+// Returned when a transaction isn't included in a block before the inclusion timeout
+type TransactionTimeoutError struct {
+	Hash    common.Hash
+	Timeout time.Duration
+}
+
+func (e *TransactionTimeoutError) Error() string {
+	return fmt.Sprintf("transaction %s was not included in a block within %s", e.Hash.Hex(), e.Timeout)
+}
+
+func (e *TransactionTimeoutError) Unwrap() error {
+	return txutils.ErrTransactionStuck
+}
+
 // Print the gas price and cost of a TX
 func PrintAndCheckGasInfo(gasInfo rocketpool.GasInfo, checkThreshold bool, gasThresholdGwei float64, logger *log.ColorLogger, maxFeeWei *big.Int, gasLimit uint64) bool {
 
@@ -55,6 +71,7 @@ func PrintAndCheckGasInfo(gasInfo rocketpool.GasInfo, checkThreshold bool, gasTh
 }
 
 // Print a TX's details to the logger and waits for it to validated.
+// If the inclusion timeout is set and the TX is still pending once it elapses, this returns a *TransactionTimeoutError with the pending hash.
 func PrintAndWaitForTransaction(cfg *config.RocketPoolConfig, hash common.Hash, ec rocketpool.ExecutionClient, logger *log.ColorLogger) error {
 
 	txWatchUrl := cfg.Smartnode.GetTxWatchUrl()
@@ -68,6 +85,20 @@ func PrintAndWaitForTransaction(cfg *config.RocketPoolConfig, hash common.Hash,
 	logger.Println("Waiting for the transaction to be validated...")
 
 	// Wait for the TX to be included in a block
+	timeout := time.Duration(cfg.Smartnode.TxInclusionTimeout.Value.(uint64)) * time.Minute
+	if timeout > 0 {
+		_, err := txutils.WaitForTransactionWithTimeout(ec, hash, timeout)
+		if errors.Is(err, txutils.ErrTransactionStuck) {
+			return &TransactionTimeoutError{
+				Hash:    hash,
+				Timeout: timeout,
+			}
+		}
+		if err != nil {
+			return fmt.Errorf("Error waiting for transaction: %w", err)
+		}
+		return nil
+	}
 	if _, err := utils.WaitForTransaction(ec, hash); err != nil {
 		return fmt.Errorf("Error waiting for transaction: %w", err)
 	}
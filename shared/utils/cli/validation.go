@@ -3,6 +3,7 @@ package cli
 import (
 	"encoding/hex"
 	"fmt"
+	"math"
 	"math/big"
 	"regexp"
 	"strconv"
@@ -72,6 +73,24 @@ func ValidateAddress(name, value string) (common.Address, error) {
 	return common.HexToAddress(value), nil
 }
 
+// Validate a comma-separated list of addresses, dropping any duplicates while keeping the original order
+func ValidateAddresses(name, value string) ([]common.Address, error) {
+	addresses := []common.Address{}
+	seen := map[common.Address]bool{}
+	for _, element := range strings.Split(value, ",") {
+		address, err := ValidateAddress(name, strings.TrimSpace(element))
+		if err != nil {
+			return nil, err
+		}
+		if seen[address] {
+			continue
+		}
+		seen[address] = true
+		addresses = append(addresses, address)
+	}
+	return addresses, nil
+}
+
 // Validate a wei amount
 func ValidateWeiAmount(name, value string) (*big.Int, error) {
 	val := new(big.Int)
@@ -84,7 +103,7 @@ func ValidateWeiAmount(name, value string) (*big.Int, error) {
 // Validate an ether amount
 func ValidateEthAmount(name, value string) (float64, error) {
 	val, err := strconv.ParseFloat(value, 64)
-	if err != nil {
+	if err != nil || math.IsNaN(val) || math.IsInf(val, 0) {
 		return 0, fmt.Errorf("Invalid %s '%s'", name, value)
 	}
 	return val, nil
@@ -148,6 +167,26 @@ func ValidateProposalType(name, value string) (string, error) {
 	return val, nil
 }
 
+// Validate a comma-separated list of minipool statuses
+func ValidateMinipoolStatuses(name, value string) ([]types.MinipoolStatus, error) {
+	statuses := []types.MinipoolStatus{}
+	for _, element := range strings.Split(value, ",") {
+		element = strings.TrimSpace(element)
+		found := false
+		for status, str := range types.MinipoolStatuses {
+			if strings.EqualFold(element, str) {
+				statuses = append(statuses, types.MinipoolStatus(status))
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("Invalid %s '%s' - valid statuses are '%s'", name, element, strings.Join(types.MinipoolStatuses, "', '"))
+		}
+	}
+	return statuses, nil
+}
+
 //
 // Command specific types
 //
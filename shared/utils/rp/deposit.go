@@ -0,0 +1,55 @@
+package rp
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rocket-pool/rocketpool-go/rocketpool"
+	"github.com/rocket-pool/rocketpool-go/settings/protocol"
+	"github.com/rocket-pool/rocketpool-go/utils/multicall"
+)
+
+// The state of the deposit pool; all amounts are in wei
+type DepositPoolStatus struct {
+	Balance         *big.Int
+	ExcessBalance   *big.Int
+	Capacity        *big.Int
+	DepositsEnabled bool
+}
+
+// Get the deposit pool's balance, excess balance, capacity, and whether deposits are enabled in a single multicall
+func GetDepositPoolStatus(rp *rocketpool.RocketPool, multicallerAddress common.Address, opts *bind.CallOpts) (DepositPoolStatus, error) {
+	status := DepositPoolStatus{}
+
+	// The multicaller needs call opts even if they're empty
+	if opts == nil {
+		opts = &bind.CallOpts{}
+	}
+
+	// Get the contracts
+	depositPool, err := rp.GetContract("rocketDepositPool", opts)
+	if err != nil {
+		return status, fmt.Errorf("error getting deposit pool contract: %w", err)
+	}
+	depositSettings, err := rp.GetContract(protocol.DepositSettingsContractName, opts)
+	if err != nil {
+		return status, fmt.Errorf("error getting deposit settings contract: %w", err)
+	}
+
+	// Get the status
+	mc, err := multicall.NewMultiCaller(rp.Client, multicallerAddress)
+	if err != nil {
+		return status, fmt.Errorf("error creating multicaller: %w", err)
+	}
+	mc.AddCall(depositPool, &status.Balance, "getBalance")
+	mc.AddCall(depositPool, &status.ExcessBalance, "getExcessBalance")
+	mc.AddCall(depositSettings, &status.Capacity, "getMaximumDepositPoolSize")
+	mc.AddCall(depositSettings, &status.DepositsEnabled, "getDepositEnabled")
+	if _, err := mc.FlexibleCall(true, opts); err != nil {
+		return status, fmt.Errorf("error executing multicall: %w", err)
+	}
+
+	return status, nil
+}
@@ -2,11 +2,15 @@ package rp
 
 import (
 	"bytes"
+	"fmt"
+	"math/big"
+	"time"
 
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/rocket-pool/rocketpool-go/minipool"
 	"github.com/rocket-pool/rocketpool-go/rocketpool"
+	"github.com/rocket-pool/rocketpool-go/settings/protocol"
 	"github.com/rocket-pool/rocketpool-go/types"
 	"golang.org/x/sync/errgroup"
 
@@ -90,3 +94,30 @@ func GetMinipoolValidators(rp *rocketpool.RocketPool, bc beacon.Client, addresse
 	return validators, nil
 
 }
+
+// Get the time remaining until the target time, or zero if it has already passed
+func GetTimeUntil(target time.Time, now time.Time) time.Duration {
+	remaining := target.Sub(now)
+	if remaining < 0 {
+		return 0
+	}
+	return remaining
+}
+
+// Get the number of active minipools in the network and the maximum the protocol DAO minipool settings allow.
+// New minipools can't be created once the active count reaches the maximum.
+func GetMinipoolLimit(rp *rocketpool.RocketPool, opts *bind.CallOpts) (uint64, uint64, error) {
+	activeCount, err := minipool.GetActiveMinipoolCount(rp, opts)
+	if err != nil {
+		return 0, 0, fmt.Errorf("error getting active minipool count: %w", err)
+	}
+	minipoolSettings, err := rp.GetContract(protocol.MinipoolSettingsContractName, opts)
+	if err != nil {
+		return 0, 0, fmt.Errorf("error getting minipool settings contract: %w", err)
+	}
+	limit := new(*big.Int)
+	if err := minipoolSettings.Call(opts, limit, "getMaximumCount"); err != nil {
+		return 0, 0, fmt.Errorf("error getting maximum minipool count: %w", err)
+	}
+	return activeCount, (*limit).Uint64(), nil
+}
@@ -72,11 +72,16 @@ func CheckCollateral(rp *rocketpool.RocketPool, nodeAddress common.Address, opts
 		return
 	}
 
-	latestBlockHeader, err := rp.Client.HeaderByNumber(context.Background(), nil)
+	// Use the time of the block being queried so historical checks see the bond reductions that were pending then
+	var blockNumber *big.Int
+	if opts != nil {
+		blockNumber = opts.BlockNumber
+	}
+	blockHeader, err := rp.Client.HeaderByNumber(context.Background(), blockNumber)
 	if err != nil {
-		return nil, nil, nil, fmt.Errorf("error getting latest block header: %w", err)
+		return nil, nil, nil, fmt.Errorf("error getting block header: %w", err)
 	}
-	blockTime := time.Unix(int64(latestBlockHeader.Time), 0)
+	blockTime := time.Unix(int64(blockHeader.Time), 0)
 	var reductionWindowStart uint64
 	var reductionWindowLength uint64
 
@@ -85,12 +90,12 @@ func CheckCollateral(rp *rocketpool.RocketPool, nodeAddress common.Address, opts
 
 	wg1.Go(func() error {
 		var err error
-		reductionWindowStart, err = tnsettings.GetBondReductionWindowStart(rp, nil)
+		reductionWindowStart, err = tnsettings.GetBondReductionWindowStart(rp, opts)
 		return err
 	})
 	wg1.Go(func() error {
 		var err error
-		reductionWindowLength, err = tnsettings.GetBondReductionWindowLength(rp, nil)
+		reductionWindowLength, err = tnsettings.GetBondReductionWindowLength(rp, opts)
 		return err
 	})
 
@@ -131,7 +136,7 @@ func CheckCollateral(rp *rocketpool.RocketPool, nodeAddress common.Address, opts
 				return fmt.Errorf("error getting bond reduction time for minipool %s: %w", address.Hex(), err)
 			}
 
-			reduceBondCancelled, err := minipool.GetReduceBondCancelled(rp, address, nil)
+			reduceBondCancelled, err := minipool.GetReduceBondCancelled(rp, address, opts)
 			if err != nil {
 				return fmt.Errorf("error getting bond reduction cancel status for minipool %s: %w", address.Hex(), err)
 			}
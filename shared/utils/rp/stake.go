@@ -0,0 +1,45 @@
+package rp
+
+import (
+	"math/big"
+
+	"github.com/rocket-pool/rocketpool-go/utils/eth"
+)
+
+// The amount of ETH (in ETH) that a minipool's validator is created with
+const MinipoolValidatorEth float64 = 32
+
+// The bond amounts (in ETH) that minipools can be created with
+var SupportedBondAmounts = []float64{8, 16}
+
+// Check whether minipools can be created with the provided bond amount
+func IsSupportedBondAmount(bondAmountWei *big.Int) bool {
+	for _, bondAmount := range SupportedBondAmounts {
+		if bondAmountWei.Cmp(eth.EthToWei(bondAmount)) == 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// Get the amount of ETH a minipool with the provided bond borrows from the deposit pool
+func GetMinipoolBorrowedEth(bondAmountWei *big.Int) *big.Int {
+	return big.NewInt(0).Sub(eth.EthToWei(MinipoolValidatorEth), bondAmountWei)
+}
+
+// Get the minimum RPL stake for a minipool with the provided bond, which is a fraction of the ETH it borrows.
+// The fraction and RPL price are the raw values from the protocol settings and network prices contracts.
+func GetMinimumPerMinipoolRplStake(bondAmountWei *big.Int, minStakeFraction *big.Int, rplPrice *big.Int) *big.Int {
+	minimumStake := big.NewInt(0).Mul(GetMinipoolBorrowedEth(bondAmountWei), minStakeFraction)
+	minimumStake.Div(minimumStake, rplPrice)
+	minimumStake.Add(minimumStake, big.NewInt(1))
+	return minimumStake
+}
+
+// Get the maximum RPL stake for a minipool with the provided bond, which is a fraction of the ETH it bonds.
+// The fraction and RPL price are the raw values from the protocol settings and network prices contracts.
+func GetMaximumPerMinipoolRplStake(bondAmountWei *big.Int, maxStakeFraction *big.Int, rplPrice *big.Int) *big.Int {
+	maximumStake := big.NewInt(0).Mul(bondAmountWei, maxStakeFraction)
+	maximumStake.Div(maximumStake, rplPrice)
+	return maximumStake
+}
@@ -0,0 +1,36 @@
+package rp
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/rocket-pool/rocketpool-go/utils/eth"
+)
+
+func TestSupportedBondAmounts(t *testing.T) {
+	if !IsSupportedBondAmount(eth.EthToWei(8)) || !IsSupportedBondAmount(eth.EthToWei(16)) {
+		t.Fatalf("expected 8 and 16 ETH bonds to be supported")
+	}
+	if IsSupportedBondAmount(eth.EthToWei(4)) || IsSupportedBondAmount(eth.EthToWei(32)) {
+		t.Fatalf("expected 4 and 32 ETH bonds not to be supported")
+	}
+}
+
+func TestPerMinipoolRplStake(t *testing.T) {
+	// 10% minimum and 150% maximum stake, with RPL at 0.01 ETH
+	minStakeFraction := eth.EthToWei(0.1)
+	maxStakeFraction := eth.EthToWei(1.5)
+	rplPrice := eth.EthToWei(0.01)
+
+	// 10% of the 24 ETH borrowed is 2.4 ETH, or 240 RPL, plus 1 wei
+	expectedMinimum := big.NewInt(0).Add(eth.EthToWei(240), big.NewInt(1))
+	if minimum := GetMinimumPerMinipoolRplStake(eth.EthToWei(8), minStakeFraction, rplPrice); minimum.Cmp(expectedMinimum) != 0 {
+		t.Fatalf("expected a minimum stake of %s, got %s", expectedMinimum.String(), minimum.String())
+	}
+
+	// 150% of the 8 ETH bonded is 12 ETH, or 1200 RPL
+	expectedMaximum := eth.EthToWei(1200)
+	if maximum := GetMaximumPerMinipoolRplStake(eth.EthToWei(8), maxStakeFraction, rplPrice); maximum.Cmp(expectedMaximum) != 0 {
+		t.Fatalf("expected a maximum stake of %s, got %s", expectedMaximum.String(), maximum.String())
+	}
+}
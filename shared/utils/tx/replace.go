@@ -0,0 +1,187 @@
+package tx
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/rocket-pool/rocketpool-go/rocketpool"
+)
+
+// The smallest fee bump, in percent, that Execution clients accept for a replacement transaction
+const MinimumBumpPercent uint64 = 10
+
+var (
+	// The transaction was still pending when the timeout elapsed
+	ErrTransactionStuck = errors.New("transaction is still pending")
+
+	// The transaction is no longer pending, so it can't be replaced
+	ErrTransactionNotPending = errors.New("transaction is not pending")
+
+	// The replacement's fees would have to go over the fee ceiling to be accepted
+	ErrFeeCeilingReached = errors.New("the fees can't be bumped without going over the fee ceiling")
+
+	// The nonce was used by a transaction other than the ones being waited on
+	ErrNonceUsed = errors.New("the nonce was used by a different transaction")
+)
+
+// How often the account nonce is checked while waiting for one of several transactions that share it
+const nonceCheckInterval = time.Second
+
+// Wait for a transaction to be mined, returning ErrTransactionStuck if it is still pending after the timeout
+func WaitForTransactionWithTimeout(ec rocketpool.ExecutionClient, hash common.Hash, timeout time.Duration) (*types.Receipt, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	// Get the transaction from its hash, retrying if it wasn't found
+	var tx *types.Transaction
+	for {
+		var err error
+		tx, _, err = ec.TransactionByHash(ctx, hash)
+		if err == nil {
+			break
+		}
+		if err.Error() != "not found" {
+			return nil, err
+		}
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("transaction %s was not found after %s", hash.Hex(), timeout)
+		case <-time.After(time.Second):
+		}
+	}
+
+	// Wait for it to be mined
+	receipt, err := bind.WaitMined(ctx, ec, tx)
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			return nil, ErrTransactionStuck
+		}
+		return nil, err
+	}
+	if receipt.Status == types.ReceiptStatusFailed {
+		return nil, fmt.Errorf("transaction %s failed with status 0", hash.Hex())
+	}
+	return receipt, nil
+}
+
+// Wait for one of several transactions that share a nonce (such as a transaction and its replacements) to be mined,
+// returning ErrTransactionStuck if none of them are mined before the timeout.
+func WaitForNonceWithTimeout(ec rocketpool.ExecutionClient, from common.Address, nonce uint64, hashes []common.Hash, timeout time.Duration) (*types.Receipt, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	for {
+		receipt, err := GetMinedTransaction(ec, from, nonce, hashes)
+		if receipt != nil || err != nil {
+			return receipt, err
+		}
+		select {
+		case <-ctx.Done():
+			return nil, ErrTransactionStuck
+		case <-time.After(nonceCheckInterval):
+		}
+	}
+}
+
+// Get the receipt of whichever one of several transactions that share a nonce was mined.
+// Returns nil if the nonce hasn't been used yet, and ErrNonceUsed if it was used by a transaction that isn't one of them.
+func GetMinedTransaction(ec rocketpool.ExecutionClient, from common.Address, nonce uint64, hashes []common.Hash) (*types.Receipt, error) {
+	minedNonce, err := ec.NonceAt(context.Background(), from, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error getting the nonce of %s: %w", from.Hex(), err)
+	}
+	if minedNonce <= nonce {
+		return nil, nil
+	}
+
+	for _, hash := range hashes {
+		receipt, err := ec.TransactionReceipt(context.Background(), hash)
+		if errors.Is(err, ethereum.NotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("error getting the receipt of transaction %s: %w", hash.Hex(), err)
+		}
+		if receipt.Status == types.ReceiptStatusFailed {
+			return receipt, fmt.Errorf("transaction %s failed with status 0", hash.Hex())
+		}
+		return receipt, nil
+	}
+	return nil, ErrNonceUsed
+}
+
+// Get transactor options for replacing a pending transaction.
+// The replacement reuses the pending transaction's nonce, and its fees are the larger of the provided ones and
+// the pending transaction's fees bumped by the given percentage (at least MinimumBumpPercent).
+// The fees are capped at the max fee ceiling if one is provided; ErrFeeCeilingReached is returned if they can't be bumped
+// by MinimumBumpPercent without going over it.
+func GetReplacementTransactOpts(ec rocketpool.ExecutionClient, opts *bind.TransactOpts, hash common.Hash, nonce uint64, bumpPercent uint64, maxFeeCeiling *big.Int) (*bind.TransactOpts, error) {
+
+	// Get the pending transaction
+	tx, isPending, err := ec.TransactionByHash(context.Background(), hash)
+	if err != nil {
+		return nil, fmt.Errorf("error getting transaction %s: %w", hash.Hex(), err)
+	}
+	if !isPending {
+		return nil, ErrTransactionNotPending
+	}
+	if tx.Nonce() != nonce {
+		return nil, fmt.Errorf("transaction %s has nonce %d but %d was expected", hash.Hex(), tx.Nonce(), nonce)
+	}
+
+	// Bump the fees
+	if bumpPercent < MinimumBumpPercent {
+		bumpPercent = MinimumBumpPercent
+	}
+	feeCap, tipCap, err := getReplacementFees(tx.GasFeeCap(), tx.GasTipCap(), opts.GasFeeCap, opts.GasTipCap, maxFeeCeiling, bumpPercent)
+	if err != nil {
+		return nil, err
+	}
+	replacementOpts := *opts
+	replacementOpts.Nonce = new(big.Int).SetUint64(nonce)
+	replacementOpts.GasFeeCap = feeCap
+	replacementOpts.GasTipCap = tipCap
+	if replacementOpts.GasLimit == 0 {
+		replacementOpts.GasLimit = tx.Gas()
+	}
+	return &replacementOpts, nil
+
+}
+
+// Get the max fee and priority fee for a replacement transaction, bumping the pending transaction's fees and capping them at the ceiling (if there is one).
+// Execution clients only accept a replacement if both of its fees are bumped by MinimumBumpPercent, so if that would go over
+// the ceiling, ErrFeeCeilingReached is returned instead.
+func getReplacementFees(pendingFeeCap *big.Int, pendingTipCap *big.Int, currentFeeCap *big.Int, currentTipCap *big.Int, ceiling *big.Int, bumpPercent uint64) (*big.Int, *big.Int, error) {
+	feeCap := getBumpedFee(pendingFeeCap, currentFeeCap, bumpPercent)
+	tipCap := getBumpedFee(pendingTipCap, currentTipCap, bumpPercent)
+	if ceiling != nil && feeCap.Cmp(ceiling) > 0 {
+		if getBumpedFee(pendingFeeCap, nil, MinimumBumpPercent).Cmp(ceiling) > 0 {
+			return nil, nil, ErrFeeCeilingReached
+		}
+		feeCap = new(big.Int).Set(ceiling)
+	}
+	if tipCap.Cmp(feeCap) > 0 {
+		if getBumpedFee(pendingTipCap, nil, MinimumBumpPercent).Cmp(feeCap) > 0 {
+			return nil, nil, ErrFeeCeilingReached
+		}
+		tipCap = new(big.Int).Set(feeCap)
+	}
+	return feeCap, tipCap, nil
+}
+
+// Get the larger of the bumped original fee and the current fee
+func getBumpedFee(original *big.Int, current *big.Int, bumpPercent uint64) *big.Int {
+	bumped := new(big.Int).Mul(original, new(big.Int).SetUint64(100+bumpPercent))
+	bumped.Div(bumped, big.NewInt(100))
+	if current != nil && current.Cmp(bumped) > 0 {
+		return new(big.Int).Set(current)
+	}
+	return bumped
+}
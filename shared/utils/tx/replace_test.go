@@ -0,0 +1,96 @@
+package tx
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/rocket-pool/rocketpool-go/rocketpool"
+)
+
+// An Execution client with a fixed account nonce and set of receipts
+type nonceClient struct {
+	rocketpool.ExecutionClient
+	nonce    uint64
+	receipts map[common.Hash]*types.Receipt
+}
+
+func (c *nonceClient) NonceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (uint64, error) {
+	return c.nonce, nil
+}
+
+func (c *nonceClient) TransactionReceipt(ctx context.Context, hash common.Hash) (*types.Receipt, error) {
+	receipt, exists := c.receipts[hash]
+	if !exists {
+		return nil, ethereum.NotFound
+	}
+	return receipt, nil
+}
+
+func TestReplacementFeesAreBumped(t *testing.T) {
+	feeCap, tipCap, err := getReplacementFees(big.NewInt(100), big.NewInt(10), big.NewInt(50), big.NewInt(5), big.NewInt(1000), 10)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if feeCap.Int64() != 110 || tipCap.Int64() != 11 {
+		t.Fatalf("expected fees of 110 and 11, got %s and %s", feeCap, tipCap)
+	}
+}
+
+func TestReplacementFeesAreCappedAtTheCeiling(t *testing.T) {
+	// The current fees are well above the ceiling, but a minimal bump still fits under it
+	feeCap, tipCap, err := getReplacementFees(big.NewInt(100), big.NewInt(10), big.NewInt(500), big.NewInt(500), big.NewInt(120), 10)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if feeCap.Int64() != 120 || tipCap.Int64() != 120 {
+		t.Fatalf("expected fees of 120 and 120, got %s and %s", feeCap, tipCap)
+	}
+}
+
+func TestReplacementFeesStopAtTheCeiling(t *testing.T) {
+	_, _, err := getReplacementFees(big.NewInt(100), big.NewInt(10), big.NewInt(100), big.NewInt(10), big.NewInt(105), 10)
+	if !errors.Is(err, ErrFeeCeilingReached) {
+		t.Fatalf("expected ErrFeeCeilingReached, got %v", err)
+	}
+}
+
+func TestEarlierTransactionIsFoundWhenMined(t *testing.T) {
+	first := common.HexToHash("0x01")
+	second := common.HexToHash("0x02")
+	ec := &nonceClient{
+		nonce: 6,
+		receipts: map[common.Hash]*types.Receipt{
+			first: {TxHash: first, Status: types.ReceiptStatusSuccessful},
+		},
+	}
+
+	receipt, err := WaitForNonceWithTimeout(ec, common.Address{}, 5, []common.Hash{first, second}, time.Second)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if receipt.TxHash != first {
+		t.Fatalf("expected the receipt of %s, got %s", first.Hex(), receipt.TxHash.Hex())
+	}
+}
+
+func TestNonceUsedByAnotherTransaction(t *testing.T) {
+	ec := &nonceClient{nonce: 6}
+	_, err := GetMinedTransaction(ec, common.Address{}, 5, []common.Hash{common.HexToHash("0x01")})
+	if !errors.Is(err, ErrNonceUsed) {
+		t.Fatalf("expected ErrNonceUsed, got %v", err)
+	}
+}
+
+func TestPendingNonceTimesOut(t *testing.T) {
+	ec := &nonceClient{nonce: 5}
+	_, err := WaitForNonceWithTimeout(ec, common.Address{}, 5, []common.Hash{common.HexToHash("0x01")}, 10*time.Millisecond)
+	if !errors.Is(err, ErrTransactionStuck) {
+		t.Fatalf("expected ErrTransactionStuck, got %v", err)
+	}
+}
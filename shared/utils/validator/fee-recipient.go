@@ -23,6 +23,22 @@ const BeaconContainerSuffix = "_eth2"
 
 var validatorRestartTimeout, _ = time.ParseDuration("5s")
 
+// Get the name of the container that runs the validator client, and a label for its client type
+func getValidatorContainerName(cfg *config.RocketPoolConfig, bc beacon.Client) (string, string, error) {
+	if cfg.Smartnode.ProjectName.Value == "" {
+		return "", "", errors.New("Rocket Pool docker project name not set")
+	}
+	clientType, _ := bc.GetClientType()
+	switch clientType {
+	case beacon.SplitProcess:
+		return cfg.Smartnode.ProjectName.Value.(string) + ValidatorContainerSuffix, "validator", nil
+	case beacon.SingleProcess:
+		return cfg.Smartnode.ProjectName.Value.(string) + BeaconContainerSuffix, "beacon", nil
+	default:
+		return "", "", fmt.Errorf("unknown client type '%d'", clientType)
+	}
+}
+
 // Restart validator process
 func RestartValidator(cfg *config.RocketPoolConfig, bc beacon.Client, log *log.ColorLogger, d *client.Client) error {
 
@@ -30,21 +46,9 @@ func RestartValidator(cfg *config.RocketPoolConfig, bc beacon.Client, log *log.C
 	if !cfg.IsNativeMode {
 
 		// Get validator container name & client type label
-		var containerName string
-		var clientTypeLabel string
-		if cfg.Smartnode.ProjectName.Value == "" {
-			return errors.New("Rocket Pool docker project name not set")
-		}
-		clientType, _ := bc.GetClientType()
-		switch clientType {
-		case beacon.SplitProcess:
-			containerName = cfg.Smartnode.ProjectName.Value.(string) + ValidatorContainerSuffix
-			clientTypeLabel = "validator"
-		case beacon.SingleProcess:
-			containerName = cfg.Smartnode.ProjectName.Value.(string) + BeaconContainerSuffix
-			clientTypeLabel = "beacon"
-		default:
-			return fmt.Errorf("Can't restart the validator, unknown client type '%d'", clientType)
+		containerName, clientTypeLabel, err := getValidatorContainerName(cfg, bc)
+		if err != nil {
+			return fmt.Errorf("Can't restart the validator: %w", err)
 		}
 
 		// Log
@@ -112,21 +116,9 @@ func StopValidator(cfg *config.RocketPoolConfig, bc beacon.Client, log *log.Colo
 	if !cfg.IsNativeMode {
 
 		// Get validator container name & client type label
-		var containerName string
-		var clientTypeLabel string
-		if cfg.Smartnode.ProjectName.Value == "" {
-			return errors.New("Rocket Pool docker project name not set")
-		}
-		clientType, _ := bc.GetClientType()
-		switch clientType {
-		case beacon.SplitProcess:
-			containerName = cfg.Smartnode.ProjectName.Value.(string) + ValidatorContainerSuffix
-			clientTypeLabel = "validator"
-		case beacon.SingleProcess:
-			containerName = cfg.Smartnode.ProjectName.Value.(string) + BeaconContainerSuffix
-			clientTypeLabel = "beacon"
-		default:
-			return fmt.Errorf("Can't stop the validator, unknown client type '%d'", clientType)
+		containerName, clientTypeLabel, err := getValidatorContainerName(cfg, bc)
+		if err != nil {
+			return fmt.Errorf("Can't stop the validator: %w", err)
 		}
 
 		// Log
@@ -193,3 +185,68 @@ func StopValidator(cfg *config.RocketPoolConfig, bc beacon.Client, log *log.Colo
 	return nil
 
 }
+
+// Check whether the validator container is running. This can't be determined for a native mode validator process.
+func IsValidatorRunning(cfg *config.RocketPoolConfig, bc beacon.Client, d *client.Client) (bool, error) {
+
+	if cfg.IsNativeMode {
+		return false, errors.New("Can't check the status of a native mode validator process")
+	}
+
+	// Get validator container name
+	containerName, _, err := getValidatorContainerName(cfg, bc)
+	if err != nil {
+		return false, fmt.Errorf("Can't check the validator: %w", err)
+	}
+
+	// Get all containers
+	containers, err := d.ContainerList(context.Background(), types.ContainerListOptions{All: true})
+	if err != nil {
+		return false, fmt.Errorf("Could not get docker containers: %w", err)
+	}
+
+	// Check the validator container's state; a missing or paused container isn't running
+	for _, container := range containers {
+		if container.Names[0] == "/"+containerName {
+			return container.State == "running", nil
+		}
+	}
+	return false, nil
+
+}
+
+// Get the time the validator container last stopped, or the zero time if it doesn't exist or has never stopped.
+// This can't be determined for a native mode validator process, or for a paused container since pausing doesn't record a time.
+func GetValidatorStopTime(cfg *config.RocketPoolConfig, bc beacon.Client, d *client.Client) (time.Time, error) {
+
+	if cfg.IsNativeMode {
+		return time.Time{}, errors.New("Can't check the status of a native mode validator process")
+	}
+
+	// Get validator container name
+	containerName, _, err := getValidatorContainerName(cfg, bc)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("Can't check the validator: %w", err)
+	}
+
+	// Get the validator container's state
+	info, err := d.ContainerInspect(context.Background(), containerName)
+	if client.IsErrNotFound(err) {
+		return time.Time{}, nil
+	}
+	if err != nil {
+		return time.Time{}, fmt.Errorf("Could not inspect validator container %s: %w", containerName, err)
+	}
+	if info.State.Paused {
+		return time.Time{}, fmt.Errorf("Validator container %s is paused, so the time it stopped isn't known", containerName)
+	}
+	if info.State.FinishedAt == "" {
+		return time.Time{}, nil
+	}
+	stopTime, err := time.Parse(time.RFC3339Nano, info.State.FinishedAt)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("Could not parse the stop time of validator container %s: %w", containerName, err)
+	}
+	return stopTime, nil
+
+}